@@ -3,14 +3,24 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
+	// Embed the timezone database so STUDIO_TIMEZONE works on images without
+	// one installed
+	_ "time/tzdata"
 
 	_ "glofox-backend/docs"
 	"glofox-backend/internal/api"
 	"glofox-backend/internal/api/handlers"
+	"glofox-backend/internal/attendance"
+	"glofox-backend/internal/checkin"
+	"glofox-backend/internal/models"
 	"glofox-backend/internal/repositories"
 )
 
@@ -25,16 +35,34 @@ func main() {
 		port = "8080"
 	}
 
+	models.SetStudioLocation(loadStudioLocation())
+
 	// Initialize repositories
-	classRepo := repositories.NewClassRepository()
-	bookingRepo := repositories.NewBookingRepository(classRepo)
+	closureRepo := repositories.NewClosureRepository()
+	instructorRepo := repositories.NewInstructorRepository()
+	classRepo := repositories.NewClassRepository(closureRepo, instructorRepo)
+	bookingRepo := repositories.NewBookingRepository(classRepo, closureRepo)
+	seriesRepo := repositories.NewBookingSeriesRepository()
+	roomRepo := repositories.NewRoomRepository()
+	memberRepo := repositories.NewMemberRepository()
 
 	// Initialize handlers
-	classHandler := handlers.NewClassHandler(classRepo)
-	bookingHandler := handlers.NewBookingHandler(bookingRepo)
+	classHandler := handlers.NewClassHandler(classRepo, bookingRepo, closureRepo, instructorRepo, roomRepo)
+	closureHandler := handlers.NewClosureHandler(closureRepo, bookingRepo)
+	instructorHandler := handlers.NewInstructorHandler(instructorRepo, classRepo)
+	roomHandler := handlers.NewRoomHandler(roomRepo, classRepo)
+	memberHandler := handlers.NewMemberHandler(memberRepo, bookingRepo)
+	cancellationPolicy := loadCancellationPolicy()
+	bookingHandler := handlers.NewBookingHandler(bookingRepo, memberRepo, cancellationPolicy)
+	seriesHandler := handlers.NewBookingSeriesHandler(seriesRepo, bookingRepo, memberRepo, cancellationPolicy)
+	checkInHandler := handlers.NewCheckInHandler(bookingRepo, loadTokenSigner())
+
+	// Cancel under-subscribed occurrences at their cutoff
+	evaluator := attendance.NewEvaluator(classRepo, bookingRepo, loadAttendanceCheckInterval())
+	go evaluator.Run(context.Background())
 
 	// Setup router
-	router := api.SetupRouter(classHandler, bookingHandler)
+	router := api.SetupRouter(classHandler, bookingHandler, seriesHandler, checkInHandler, closureHandler, instructorHandler, roomHandler, memberHandler)
 
 	// Start server
 	serverAddr := fmt.Sprintf(":%s", port)
@@ -43,3 +71,83 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// loadStudioLocation reads the IANA timezone of the studio from the
+// environment, falling back to UTC
+func loadStudioLocation() *time.Location {
+	value := os.Getenv("STUDIO_TIMEZONE")
+	if value == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(value)
+	if err != nil {
+		log.Fatalf("Invalid STUDIO_TIMEZONE: %q", value)
+	}
+	log.Printf("Using studio timezone %s", location)
+	return location
+}
+
+// loadCancellationPolicy reads the cancellation policy from the environment,
+// falling back to the defaults for unset values
+func loadCancellationPolicy() models.CancellationPolicy {
+	policy := models.DefaultCancellationPolicy()
+
+	if value := os.Getenv("CANCELLATION_WINDOW_HOURS"); value != "" {
+		hours, err := strconv.Atoi(value)
+		if err != nil || hours < 0 {
+			log.Fatalf("Invalid CANCELLATION_WINDOW_HOURS: %q", value)
+		}
+		policy.FreeCancellationWindow = time.Duration(hours) * time.Hour
+	}
+
+	if value := os.Getenv("ALLOW_LATE_CANCELLATION"); value != "" {
+		allow, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Fatalf("Invalid ALLOW_LATE_CANCELLATION: %q", value)
+		}
+		policy.AllowLateCancellation = allow
+	}
+
+	return policy
+}
+
+// loadAttendanceCheckInterval reads how often occurrences are checked against
+// the minimum attendance of their class from the environment
+func loadAttendanceCheckInterval() time.Duration {
+	value := os.Getenv("ATTENDANCE_CHECK_INTERVAL_SECONDS")
+	if value == "" {
+		return time.Minute
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 1 {
+		log.Fatalf("Invalid ATTENDANCE_CHECK_INTERVAL_SECONDS: %q", value)
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// loadTokenSigner builds the check-in token signer from the environment. When
+// no secret is configured a random one is generated, so issued tokens stop
+// verifying after a restart.
+func loadTokenSigner() *checkin.TokenSigner {
+	ttl := 15 * time.Minute
+	if value := os.Getenv("CHECKIN_TOKEN_TTL_MINUTES"); value != "" {
+		minutes, err := strconv.Atoi(value)
+		if err != nil || minutes < 1 {
+			log.Fatalf("Invalid CHECKIN_TOKEN_TTL_MINUTES: %q", value)
+		}
+		ttl = time.Duration(minutes) * time.Minute
+	}
+
+	secret := []byte(os.Getenv("CHECKIN_TOKEN_SECRET"))
+	if len(secret) == 0 {
+		log.Printf("CHECKIN_TOKEN_SECRET is not set, using a random secret")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatalf("Failed to generate check-in token secret: %v", err)
+		}
+	}
+
+	return checkin.NewTokenSigner(secret, ttl)
+}
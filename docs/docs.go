@@ -15,19 +15,19 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/bookings": {
+        "/booking-series": {
             "get": {
-                "description": "Retrieves a list of all bookings",
+                "description": "Retrieves a list of all recurring booking series",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-series"
                 ],
-                "summary": "Get all bookings",
+                "summary": "Get all booking series",
                 "responses": {
                     "200": {
-                        "description": "List of bookings",
+                        "description": "List of booking series",
                         "schema": {
                             "allOf": [
                                 {
@@ -39,7 +39,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/models.Booking"
+                                                "$ref": "#/definitions/models.BookingSeries"
                                             }
                                         }
                                     }
@@ -50,7 +50,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new booking for a member to attend a class",
+                "description": "Books a class on every matching weekday of a weekly pattern, e.g. every Tuesday for the next 8 weeks. The member is given by memberId, whose name is used for the bookings, or by name alone. Dates that cannot be booked are reported in failures.",
                 "consumes": [
                     "application/json"
                 ],
@@ -58,23 +58,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-series"
                 ],
-                "summary": "Create a new booking",
+                "summary": "Create a recurring booking series",
                 "parameters": [
                     {
-                        "description": "Booking information",
-                        "name": "booking",
+                        "description": "Series information",
+                        "name": "series",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.BookingInput"
+                            "$ref": "#/definitions/models.BookingSeriesInput"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Booking created successfully",
+                        "description": "Series created",
                         "schema": {
                             "allOf": [
                                 {
@@ -84,7 +84,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/models.Booking"
+                                            "$ref": "#/definitions/models.BookingSeries"
                                         }
                                     }
                                 }
@@ -92,28 +92,46 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid input, class or member not found or member inactive",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
+                    },
+                    "409": {
+                        "description": "No date of the series could be booked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.BookingSeries"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     }
                 }
             }
         },
-        "/bookings/{id}": {
+        "/booking-series/{id}": {
             "get": {
-                "description": "Retrieves a booking by its ID",
+                "description": "Retrieves a recurring booking series by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "bookings"
+                    "booking-series"
                 ],
-                "summary": "Get booking by ID",
+                "summary": "Get booking series by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Booking ID",
+                        "description": "Series ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -121,7 +139,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Booking found",
+                        "description": "Series found",
                         "schema": {
                             "allOf": [
                                 {
@@ -131,7 +149,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/models.Booking"
+                                            "$ref": "#/definitions/models.BookingSeries"
                                         }
                                     }
                                 }
@@ -139,7 +157,76 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Booking not found",
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels every booking of a series (scope=all) or only the ones from today onwards (scope=remaining). Each booking is cancelled according to the studio cancellation policy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "booking-series"
+                ],
+                "summary": "Cancel a booking series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "all or remaining (default remaining)",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the series",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason for the cancellation",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series cancelled",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.SeriesCancellationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scope",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
@@ -147,27 +234,81 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes": {
+        "/bookings": {
             "get": {
-                "description": "Retrieves a list of all classes, optionally filtered by date",
+                "description": "Retrieves a list of all bookings, optionally filtered by class, occurrence, member, series, status, class date range and creation time range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "classes"
+                    "bookings"
                 ],
-                "summary": "Get all classes",
+                "summary": "Get all bookings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter classes by date (YYYY-MM-DD)",
-                        "name": "date",
+                        "description": "Filter by class ID",
+                        "name": "classId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by class occurrence ID",
+                        "name": "occurrenceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by member ID",
+                        "name": "memberId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by member name (case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by booking series ID",
+                        "name": "seriesId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (pending, confirmed, waitlisted, cancelled, attended, no-show)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest class date (YYYY-MM-DD)",
+                        "name": "dateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest class date (YYYY-MM-DD)",
+                        "name": "dateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest creation time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest creation time (RFC 3339 or YYYY-MM-DD)",
+                        "name": "createdTo",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of classes",
+                        "description": "List of bookings",
                         "schema": {
                             "allOf": [
                                 {
@@ -179,7 +320,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/models.Class"
+                                                "$ref": "#/definitions/models.Booking"
                                             }
                                         }
                                     }
@@ -188,7 +329,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid date format",
+                        "description": "Invalid filter",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
@@ -196,7 +337,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new fitness class with the provided details",
+                "description": "Creates a new booking for a member to attend a class, given by classId and date or by occurrenceId. The member is given by memberId, whose name is used for the booking, or by name alone. When the class is full and waitlist is set, the member is added to the waitlist instead.",
                 "consumes": [
                     "application/json"
                 ],
@@ -204,23 +345,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "classes"
+                    "bookings"
                 ],
-                "summary": "Create a new class",
+                "summary": "Create a new booking",
                 "parameters": [
                     {
-                        "description": "Class information",
-                        "name": "class",
+                        "description": "Booking information",
+                        "name": "booking",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.ClassInput"
+                            "$ref": "#/definitions/models.BookingInput"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Class created successfully",
+                        "description": "Booking created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -230,7 +371,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/models.Class"
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "202": {
+                        "description": "Class is full, added to waitlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.WaitlistEntry"
                                         }
                                     }
                                 }
@@ -238,13 +397,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid input, member not found or inactive",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "409": {
+                        "description": "Class is full (data is a CapacityInfo) or member already booked (data is a DuplicateBookingInfo)",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
@@ -252,20 +411,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes/{id}": {
+        "/bookings/{id}": {
             "get": {
-                "description": "Retrieves a class by its ID",
+                "description": "Retrieves a booking by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "classes"
+                    "bookings"
                 ],
-                "summary": "Get class by ID",
+                "summary": "Get booking by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Class ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -273,7 +432,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Class found",
+                        "description": "Booking found",
                         "schema": {
                             "allOf": [
                                 {
@@ -283,7 +442,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/models.Class"
+                                            "$ref": "#/definitions/models.Booking"
                                         }
                                     }
                                 }
@@ -291,99 +450,3774 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Class not found",
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancels a booking according to the studio cancellation policy. Cancellations inside the free cancellation window are flagged as late, or rejected if the policy does not allow them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookings"
+                ],
+                "summary": "Cancel a booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the booking",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason for the cancellation",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Booking cancelled",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking cannot be cancelled",
                         "schema": {
                             "$ref": "#/definitions/responses.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Booking": {
-            "type": "object",
-            "properties": {
-                "classId": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "date": {
-                    "type": "string"
-                },
-                "id": {
+        },
+        "/bookings/{id}/check-in": {
+            "post": {
+                "description": "Marks the member of a booking as attended. Check-in opens on the day of the class.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "Check in a booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member checked in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking cannot be checked in",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookings/{id}/check-in-token": {
+            "get": {
+                "description": "Issues a signed, time-limited token for a confirmed booking that the member can present as a QR code",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "Issue a check-in token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Check-in token",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/checkin.Token"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking is not confirmed",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookings/{id}/reschedule": {
+            "post": {
+                "description": "Moves a confirmed booking to another date and/or class in one step. The original booking is kept if the target class date is unavailable or full.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookings"
+                ],
+                "summary": "Reschedule a booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target date and optional class",
+                        "name": "reschedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RescheduleInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Booking rescheduled",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or no class on the requested date",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Target is full, member already booked there or booking cannot be rescheduled",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/bookings/{id}/status": {
+            "patch": {
+                "description": "Moves a booking to a new status, e.g. to mark it attended or no-show. A booking can be marked attended from the day of the class, as with check-in. Cancellations go through DELETE /bookings/{id} so the cancellation policy applies, and waitlisted bookings are confirmed only from the waitlist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bookings"
+                ],
+                "summary": "Update booking status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingStatusInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Booking status updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed or check-in not open yet",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/check-in": {
+            "post": {
+                "description": "Verifies a check-in token presented by a member and marks the booking as attended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "Check in with a token",
+                "parameters": [
+                    {
+                        "description": "Check-in token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CheckInInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member checked in",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Booking"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired token",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking cannot be checked in",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/check-in/roster": {
+            "post": {
+                "description": "Marks the bookings on a class date as attended, either all of them or the listed booking IDs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "Check in a class roster",
+                "parameters": [
+                    {
+                        "description": "Roster check-in",
+                        "name": "roster",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RosterCheckInInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Per-booking check-in results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handlers.RosterCheckInResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes": {
+            "get": {
+                "description": "Retrieves a list of all classes, optionally filtered by date, instructor, room, category, tags and difficulty. Filters combine.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Get all classes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter classes by date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter classes taught by an instructor, including as a substitute",
+                        "name": "instructorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter classes held in a room",
+                        "name": "roomId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter classes by category, e.g. yoga",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags a class must all have",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter classes by difficulty (all-levels, beginner, intermediate, advanced)",
+                        "name": "difficulty",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of classes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Class"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date format or difficulty",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new fitness class with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Create a new class",
+                "parameters": [
+                    {
+                        "description": "Class information",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Class created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Class"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or resolution, instructor or room not found, or capacity above the room capacity",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Instructor already teaches at the same time, or the room is in use (data=RoomConflictInfo)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.InstructorConflictInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/search": {
+            "get": {
+                "description": "Full-text search over class names, descriptions, categories, tags and instructor names. Matching is case-insensitive and every word of the query must match, a word also matching the start of longer ones. Results are ranked with name matches first and archived classes left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Search classes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search words",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching classes, best first",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/repositories.ClassSearchResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Missing query or invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}": {
+            "get": {
+                "description": "Retrieves a class by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Get class by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Class found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Class"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces all editable fields of a class. Changes that would strand upcoming bookings (dates removed from the range, capacity below the booked count) are rejected unless force is set. Forcing it cancels the waitlist of the removed dates. A capacity below the booked count can instead be resolved: resolution=waitlist or resolution=cancel keeps the earliest bookings of each date and moves the rest to the front of the waitlist or cancels them, reported per date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Replace a class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Class information",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply the update even if it strands bookings",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reject, waitlist or cancel bookings above a reduced capacity (default reject)",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the bookings above the capacity",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason given to the bookings cancelled above the capacity",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Class updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClassUpdateResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or resolution, instructor or room not found, or capacity above the room capacity",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Update would strand bookings, the instructor already teaches at the same time (data=InstructorConflictInfo) or the room is in use (data=RoomConflictInfo)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BookingConflict"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a class. mode=reject (default) refuses when the class has bookings, mode=cascade cancels upcoming bookings and deletes the class, mode=archive cancels upcoming bookings and keeps the class and its history but stops taking bookings. The cancelled bookings are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Delete a class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "reject, cascade or archive (default reject)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the class",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason given to the cancelled bookings",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Class removed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClassRemovalResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid mode",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Class has bookings",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Booking"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates the given fields of a class. Changes that would strand upcoming bookings (dates removed from the range, capacity below the booked count) are rejected unless force is set. Forcing it cancels the waitlist of the removed dates. A capacity below the booked count can instead be resolved: resolution=waitlist or resolution=cancel keeps the earliest bookings of each date and moves the rest to the front of the waitlist or cancels them, reported per date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Update a class",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassPatchInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply the update even if it strands bookings",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reject, waitlist or cancel bookings above a reduced capacity (default reject)",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the bookings above the capacity",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason given to the bookings cancelled above the capacity",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Class updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClassUpdateResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or resolution, instructor or room not found, or capacity above the room capacity",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Update would strand bookings, the instructor already teaches at the same time (data=InstructorConflictInfo) or the room is in use (data=RoomConflictInfo)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BookingConflict"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/availability": {
+            "get": {
+                "description": "Retrieves capacity, booked spots, waitlist size and remaining spots for each date the class runs in a date range, leaving out studio closures",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Get class availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD), defaults to the class start date, or today for classes running more than 366 days",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD), defaults to the class end date, at most 366 days after the first date",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Availability per date",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Availability"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date range",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/occurrences": {
+            "get": {
+                "description": "Expands the class schedule into the occurrences it actually runs on in a date range, with overrides applied and cancelled occurrences and studio closures left out. Occurrence IDs are stable and can be booked with occurrenceId.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Get class occurrences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD), defaults to the class start date, or today for classes running more than 366 days",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD), defaults to the class end date, at most 366 days after the first date",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrences in date order",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Occurrence"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date range",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/overrides": {
+            "get": {
+                "description": "Retrieves the changes made to single occurrences of a class, in date order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Get class occurrence overrides",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Overrides",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.ClassOverride"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/classes/{id}/overrides/{date}": {
+            "put": {
+                "description": "Changes a single occurrence of a class: cancels it (cancelling its bookings), changes its capacity, moves its session time or sets a substitute instructor. Replaces any earlier override of the same date. A capacity below the booked count is rejected unless force is set, or resolved with resolution=waitlist or resolution=cancel, which keeps the earliest bookings and moves the rest to the front of the waitlist or cancels them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Override a class occurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurrence date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Occurrence changes",
+                        "name": "override",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClassOverrideInput"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apply the override even if the occurrence is overbooked",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reject, waitlist or cancel bookings above a reduced capacity (default reject)",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the occurrence",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason given to the bookings cancelled above the capacity",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrence updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClassOverrideResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or resolution, instructor not found, capacity above the room capacity or the class does not run on the date",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Occurrence is overbooked, or the substitute already teaches at the same time (data=InstructorConflictInfo)",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BookingConflict"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Restores a single occurrence of a class to its regular schedule. Bookings cancelled with the occurrence stay cancelled. Restoring a capacity below the booked count is rejected unless force is set or resolved with resolution=waitlist or resolution=cancel.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "classes"
+                ],
+                "summary": "Remove a class occurrence override",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurrence date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Restore the occurrence even if it is overbooked",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reject, waitlist or cancel bookings above the restored capacity (default reject)",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who cancelled the bookings above the capacity",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason given to the bookings cancelled above the capacity",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrence restored",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClassOverrideResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date or resolution, or the class does not run on the date",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Class or override not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Occurrence is overbooked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BookingConflict"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/closures": {
+            "get": {
+                "description": "Retrieves all studio closures ordered by start date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Get all studio closures",
+                "responses": {
+                    "200": {
+                        "description": "List of closures",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Closure"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Closes the studio on a day or range of days, e.g. a public holiday or maintenance. No class can be booked while the studio is closed. Bookings that already fall on the closure are cancelled when cancelBookings is set, otherwise they are listed in affectedBookings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Create a studio closure",
+                "parameters": [
+                    {
+                        "description": "Closure information",
+                        "name": "closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who closed the studio",
+                        "name": "cancelledBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Closure created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.ClosureResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/closures/{id}": {
+            "get": {
+                "description": "Retrieves a studio closure by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Get closure by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Closure ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Closure found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Closure"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Closure not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Reopens the studio on the days of a closure. Bookings cancelled with the closure stay cancelled.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Delete a studio closure",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Closure ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Closure deleted",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Closure not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/instructors": {
+            "get": {
+                "description": "Retrieves all instructors ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "instructors"
+                ],
+                "summary": "Get all instructors",
+                "responses": {
+                    "200": {
+                        "description": "List of instructors",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Instructor"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates an instructor who can be assigned to classes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "instructors"
+                ],
+                "summary": "Create a new instructor",
+                "parameters": [
+                    {
+                        "description": "Instructor information",
+                        "name": "instructor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InstructorInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Instructor created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Instructor"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/instructors/{id}": {
+            "get": {
+                "description": "Retrieves an instructor by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "instructors"
+                ],
+                "summary": "Get instructor by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Instructor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Instructor found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Instructor"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Instructor not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name, bio and contact details of an instructor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "instructors"
+                ],
+                "summary": "Update an instructor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Instructor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Instructor information",
+                        "name": "instructor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.InstructorInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Instructor updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Instructor"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Instructor not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an instructor who is not assigned to any active class. Classes still taught by the instructor, including as a substitute, are returned with a conflict.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "instructors"
+                ],
+                "summary": "Delete an instructor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Instructor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Instructor deleted",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Instructor not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Instructor is assigned to classes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Class"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/members": {
+            "get": {
+                "description": "Retrieves all members ordered by name, optionally only those with a name matching case-insensitively",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get all members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by name (case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of members",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Member"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a studio member who can book classes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Create a new member",
+                "parameters": [
+                    {
+                        "description": "Member information",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MemberInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Member created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Member"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/link-bookings": {
+            "post": {
+                "description": "Matches the bookings that hold only a name to the member with that name, ignoring case and extra whitespace, and links them. Names no member has are reported as unmatched, or get a new member with create=true. Names more than one member has are reported as ambiguous and left alone. A booking for a class date the member already holds another booking for is left unlinked and reported as skipped. Safe to run again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Link name-only bookings to members",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Create a member for each unmatched name",
+                        "name": "create",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookings linked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handlers.MemberLinkResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/members/{id}": {
+            "get": {
+                "description": "Retrieves a member by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Get member by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Member"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Member not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name, contact details and status of a member, keeping the current status when none is given. Inactive members cannot make new bookings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Update a member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Member information",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MemberInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Member"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Member not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a member who holds no bookings. A member with bookings is kept for their history and can be set inactive instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "members"
+                ],
+                "summary": "Delete a member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Member deleted",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Member not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Member has bookings",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Booking"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms": {
+            "get": {
+                "description": "Retrieves all studio rooms ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Get all rooms",
+                "responses": {
+                    "200": {
+                        "description": "List of rooms",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Room"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a studio room that classes can be held in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Create a new room",
+                "parameters": [
+                    {
+                        "description": "Room information",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RoomInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Room created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Room"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms/{id}": {
+            "get": {
+                "description": "Retrieves a studio room by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Get room by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Room found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Room"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Room not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the name, description and capacity of a room. Reducing the capacity below that of a class held in the room is rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Update a room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room information",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RoomInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Room updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Room"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Room not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Classes in the room no longer fit",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Class"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a room that no active class is held in. Classes still held in the room are returned with a conflict.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Delete a room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Room deleted",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Room not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "Room is assigned to classes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Class"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist": {
+            "get": {
+                "description": "Retrieves the members waiting for a spot on a class date, in queue order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get the waitlist for a class date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "classId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Class date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Waitlist entries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.WaitlistEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/promotions": {
+            "get": {
+                "description": "Retrieves the waitlist entries promoted to bookings for a class date, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get waitlist promotion history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Class ID",
+                        "name": "classId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Class date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promoted entries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.WaitlistEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/{id}": {
+            "get": {
+                "description": "Retrieves a waitlist entry with its current position, or its promotion details once promoted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get waitlist entry by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Waitlist entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Waitlist entry found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/responses.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.WaitlistEntry"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Waitlist entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "checkin.Token": {
+            "type": "object",
+            "properties": {
+                "bookingId": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.ClassOverrideResult": {
+            "type": "object",
+            "properties": {
+                "cancelledBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "class": {
+                    "$ref": "#/definitions/models.Class"
+                },
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingConflict"
+                    }
+                },
+                "overbooking": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OverbookingReport"
+                    }
+                },
+                "override": {
+                    "$ref": "#/definitions/models.ClassOverride"
+                }
+            }
+        },
+        "handlers.ClassRemovalResult": {
+            "type": "object",
+            "properties": {
+                "affectedBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "class": {
+                    "$ref": "#/definitions/models.Class"
+                },
+                "mode": {
+                    "$ref": "#/definitions/models.ClassRemovalMode"
+                }
+            }
+        },
+        "handlers.ClassUpdateResult": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "$ref": "#/definitions/models.Class"
+                },
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingConflict"
+                    }
+                },
+                "overbooking": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OverbookingReport"
+                    }
+                }
+            }
+        },
+        "handlers.ClosureResult": {
+            "type": "object",
+            "properties": {
+                "affectedBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "cancelledBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "closure": {
+                    "$ref": "#/definitions/models.Closure"
+                }
+            }
+        },
+        "handlers.InstructorConflictInfo": {
+            "type": "object",
+            "properties": {
+                "classId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "instructorId": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.MemberBookingLink": {
+            "type": "object",
+            "properties": {
+                "bookingIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created": {
+                    "type": "boolean"
+                },
+                "memberId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skippedBookingIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.MemberLinkResult": {
+            "type": "object",
+            "properties": {
+                "ambiguous": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "linked": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.MemberBookingLink"
+                    }
+                },
+                "unmatched": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.RosterCheckInResult": {
+            "type": "object",
+            "properties": {
+                "bookingId": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.BookingStatus"
+                }
+            }
+        },
+        "handlers.SeriesCancellationResult": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SeriesFailure"
+                    }
+                },
+                "series": {
+                    "$ref": "#/definitions/models.BookingSeries"
+                }
+            }
+        },
+        "models.Availability": {
+            "type": "object",
+            "properties": {
+                "booked": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "occurrenceId": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "session": {
+                    "$ref": "#/definitions/models.Session"
+                },
+                "waitlisted": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Booking": {
+            "type": "object",
+            "properties": {
+                "cancellation": {
+                    "$ref": "#/definitions/models.Cancellation"
+                },
+                "classId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "memberId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occurrenceId": {
+                    "description": "OccurrenceID identifies the class occurrence the booking is for, and\nMemberID the member holding it. Bookings made before members existed\nhold only the name until they are linked.",
+                    "type": "string"
+                },
+                "reschedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Reschedule"
+                    }
+                },
+                "seriesId": {
+                    "type": "string"
+                },
+                "session": {
+                    "description": "Session is the class session the booking is for. A start requested\nwith an RFC 3339 date is checked against the class when booking.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Session"
+                        }
+                    ]
+                },
+                "status": {
+                    "$ref": "#/definitions/models.BookingStatus"
+                },
+                "statusHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusChange"
+                    }
+                }
+            }
+        },
+        "models.BookingConflict": {
+            "type": "object",
+            "properties": {
+                "booked": {
+                    "type": "integer"
+                },
+                "bookingIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingInput": {
+            "type": "object",
+            "properties": {
+                "classId": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date takes a YYYY-MM-DD date or the RFC 3339 start of a session",
+                    "type": "string"
+                },
+                "memberId": {
+                    "description": "MemberID books for a member, whose name is used for the booking.\nName alone is still accepted for walk-ins.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occurrenceId": {
+                    "description": "OccurrenceID books an occurrence from GET /classes/{id}/occurrences\ninstead of a classId and date",
+                    "type": "string"
+                },
+                "waitlist": {
+                    "description": "Waitlist places the member on the waitlist when the class is full",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.BookingSeries": {
+            "type": "object",
+            "properties": {
+                "bookingIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "classId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SeriesFailure"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "intervalWeeks": {
+                    "type": "integer"
+                },
+                "memberId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.BookingSeriesInput": {
+            "type": "object",
+            "required": [
+                "classId",
+                "startDate",
+                "weekdays"
+            ],
+            "properties": {
+                "classId": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "description": "EndDate is the last date of the series, used instead of weeks",
+                    "type": "string"
+                },
+                "intervalWeeks": {
+                    "description": "IntervalWeeks repeats the series every N weeks, defaults to 1",
+                    "type": "integer"
+                },
+                "memberId": {
+                    "description": "MemberID books the series for a member, whose name is used for the\nbookings. Name alone is still accepted.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "weeks": {
+                    "description": "Weeks is how many weeks the series runs for, from the start date",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BookingStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "confirmed",
+                "waitlisted",
+                "cancelled",
+                "attended",
+                "no-show"
+            ],
+            "x-enum-varnames": [
+                "BookingStatusPending",
+                "BookingStatusConfirmed",
+                "BookingStatusWaitlisted",
+                "BookingStatusCancelled",
+                "BookingStatusAttended",
+                "BookingStatusNoShow"
+            ]
+        },
+        "models.BookingStatusInput": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Cancellation": {
+            "type": "object",
+            "properties": {
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "cancelledBy": {
+                    "type": "string"
+                },
+                "late": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CapacityResolution": {
+            "type": "string",
+            "enum": [
+                "reject",
+                "waitlist",
+                "cancel"
+            ],
+            "x-enum-varnames": [
+                "CapacityResolutionReject",
+                "CapacityResolutionWaitlist",
+                "CapacityResolutionCancel"
+            ]
+        },
+        "models.CheckInInput": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Class": {
+            "type": "object",
+            "properties": {
+                "archivedAt": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "category": {
+                    "description": "Category and Tags are stored lowercased",
+                    "type": "string"
+                },
+                "className": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "cutoffMinutes": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "$ref": "#/definitions/models.ClassDifficulty"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "exDates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "instructorId": {
+                    "type": "string"
+                },
+                "minAttendance": {
+                    "description": "MinAttendance is the fewest bookings an occurrence needs to run. At\nCutoffMinutes before its start an occurrence with fewer bookings is\ncancelled.",
+                    "type": "integer"
+                },
+                "overrides": {
+                    "description": "Overrides holds changes to single occurrences, keyed by YYYY-MM-DD date",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.ClassOverride"
+                    }
+                },
+                "recurrence": {
+                    "description": "Recurrence is an RFC 5545 RRULE limiting the dates between StartDate\nand EndDate the class runs on, it runs every day when empty",
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "StartTime is the HH:MM time the daily session starts, the class runs\nall day when empty",
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ClassDifficulty": {
+            "type": "string",
+            "enum": [
+                "all-levels",
+                "beginner",
+                "intermediate",
+                "advanced"
+            ],
+            "x-enum-varnames": [
+                "ClassDifficultyAllLevels",
+                "ClassDifficultyBeginner",
+                "ClassDifficultyIntermediate",
+                "ClassDifficultyAdvanced"
+            ]
+        },
+        "models.ClassInput": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "className",
+                "endDate",
+                "startDate"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "category": {
+                    "description": "Category is the kind of class, such as yoga, hiit or spin, and Tags\nare free-form labels",
+                    "type": "string"
+                },
+                "className": {
+                    "type": "string"
+                },
+                "cutoffMinutes": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "description": "Difficulty is all-levels, beginner, intermediate or advanced",
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "exDates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "instructorId": {
+                    "description": "InstructorID assigns an instructor to every session of the class",
+                    "type": "string"
+                },
+                "minAttendance": {
+                    "description": "MinAttendance cancels occurrences with fewer bookings CutoffMinutes\nbefore they start, 0 runs every occurrence. CutoffMinutes is required\nwith a minimum attendance.",
+                    "type": "integer"
+                },
+                "recurrence": {
+                    "description": "Recurrence is an RFC 5545 RRULE such as FREQ=WEEKLY;BYDAY=MO,WE,FR and\nExDates lists YYYY-MM-DD dates it skips",
+                    "type": "string"
+                },
+                "roomId": {
+                    "description": "RoomID is the room the class takes place in, its capacity caps the\nclass capacity",
+                    "type": "string"
+                },
+                "startDate": {
+                    "description": "StartDate and EndDate take YYYY-MM-DD dates or RFC 3339 datetimes",
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "StartTime is the HH:MM time the daily session starts, with either\nEndTime or DurationMinutes for its length",
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ClassOverride": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "capacity": {
+                    "description": "Capacity replaces the class capacity on this date when set",
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "instructorId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "StartTime and DurationMinutes replace the session time on this date\nwhen set",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ClassOverrideInput": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "description": "Cancelled cancels the occurrence and every booking on it",
+                    "type": "boolean"
+                },
+                "capacity": {
+                    "description": "Capacity replaces the class capacity on this date, 0 keeps it",
+                    "type": "integer"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "instructorId": {
+                    "description": "InstructorID is the substitute teaching this occurrence",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "StartTime moves the session, with EndTime or DurationMinutes to change\nits length",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ClassPatchInput": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "className": {
+                    "type": "string"
+                },
+                "cutoffMinutes": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "exDates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "instructorId": {
+                    "type": "string"
+                },
+                "minAttendance": {
+                    "type": "integer"
+                },
+                "recurrence": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ClassRemovalMode": {
+            "type": "string",
+            "enum": [
+                "reject",
+                "cascade",
+                "archive"
+            ],
+            "x-enum-varnames": [
+                "ClassRemovalReject",
+                "ClassRemovalCascade",
+                "ClassRemovalArchive"
+            ]
+        },
+        "models.Closure": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ClosureInput": {
+            "type": "object",
+            "required": [
+                "reason",
+                "startDate"
+            ],
+            "properties": {
+                "cancelBookings": {
+                    "description": "CancelBookings cancels the bookings that fall on the closure",
+                    "type": "boolean"
+                },
+                "endDate": {
+                    "description": "EndDate is the last closed day, the closure is a single day when empty",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Instructor": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "models.BookingInput": {
+        "models.InstructorInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Member": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.MemberStatus"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.MemberInput": {
             "type": "object",
             "required": [
-                "classId",
-                "date",
                 "name"
             ],
             "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is active or inactive. New members default to active, an\nupdate without it keeps the current status.",
+                    "type": "string"
+                }
+            }
+        },
+        "models.MemberStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "inactive"
+            ],
+            "x-enum-varnames": [
+                "MemberStatusActive",
+                "MemberStatusInactive"
+            ]
+        },
+        "models.Occurrence": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
                 "classId": {
                     "type": "string"
                 },
                 "date": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
+                    "description": "ID identifies the occurrence as \u003cclassId\u003e:\u003cYYYY-MM-DD\u003e and stays the\nsame however the class schedule changes",
+                    "type": "string"
+                },
+                "instructorId": {
+                    "type": "string"
+                },
+                "overridden": {
+                    "description": "Overridden is set when the occurrence differs from the regular schedule",
+                    "type": "boolean"
+                },
+                "roomId": {
                     "type": "string"
+                },
+                "session": {
+                    "$ref": "#/definitions/models.Session"
                 }
             }
         },
-        "models.Class": {
+        "models.OverbookingReport": {
             "type": "object",
             "properties": {
+                "affectedBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Booking"
+                    }
+                },
                 "capacity": {
                     "type": "integer"
                 },
-                "className": {
+                "date": {
+                    "type": "string"
+                },
+                "keptBookingIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resolution": {
+                    "$ref": "#/definitions/models.CapacityResolution"
+                }
+            }
+        },
+        "models.Reschedule": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "fromClassId": {
+                    "type": "string"
+                },
+                "fromDate": {
+                    "type": "string"
+                },
+                "toClassId": {
+                    "type": "string"
+                },
+                "toDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RescheduleInput": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "classId": {
+                    "description": "ClassID moves the booking to another class, the current class is kept when empty",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date takes a YYYY-MM-DD date or the RFC 3339 start of a session",
                     "type": "string"
+                }
+            }
+        },
+        "models.Room": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "Capacity is the number of people the room physically holds",
+                    "type": "integer"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "endDate": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "startDate": {
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "models.ClassInput": {
+        "models.RoomInput": {
             "type": "object",
             "required": [
                 "capacity",
-                "className",
-                "endDate",
-                "startDate"
+                "name"
             ],
             "properties": {
                 "capacity": {
                     "type": "integer",
                     "minimum": 1
                 },
-                "className": {
+                "description": {
                     "type": "string"
                 },
-                "endDate": {
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "models.RosterCheckInInput": {
+            "type": "object",
+            "required": [
+                "classId",
+                "date"
+            ],
+            "properties": {
+                "bookingIds": {
+                    "description": "BookingIDs limits the check-in to these bookings, all bookings on the\nroster are checked in when empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "startDate": {
+                "classId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SeriesFailure": {
+            "type": "object",
+            "properties": {
+                "bookingId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Session": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.StatusChange": {
+            "type": "object",
+            "properties": {
+                "at": {
                     "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.BookingStatus"
+                }
+            }
+        },
+        "models.WaitlistEntry": {
+            "type": "object",
+            "properties": {
+                "bookingId": {
+                    "type": "string"
+                },
+                "classId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "promotedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "repositories.ClassSearchResult": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "$ref": "#/definitions/models.Class"
+                },
+                "score": {
+                    "type": "number"
                 }
             }
         },
@@ -415,7 +4249,6 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "API for managing studio classes and bookings",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
-	
 }
 
 func init() {
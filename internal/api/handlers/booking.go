@@ -4,7 +4,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"glofox-backend/internal/api/responses"
 	"glofox-backend/internal/models"
@@ -15,23 +19,41 @@ import (
 
 // BookingHandler handles HTTP requests related to bookings
 type BookingHandler struct {
-	repo repositories.BookingRepository
+	repo    repositories.BookingRepository
+	members repositories.MemberRepository
+	policy  models.CancellationPolicy
+}
+
+// CapacityInfo describes how full a class is on a given date
+type CapacityInfo struct {
+	ClassID        string `json:"classId"`
+	Date           string `json:"date"`
+	Capacity       int    `json:"capacity"`
+	Booked         int    `json:"booked"`
+	RemainingSpots int    `json:"remainingSpots"`
+}
+
+// DuplicateBookingInfo points to the booking a member already holds
+type DuplicateBookingInfo struct {
+	ExistingBookingID string `json:"existingBookingId"`
 }
 
 // NewBookingHandler creates a new BookingHandler instance
-func NewBookingHandler(repo repositories.BookingRepository) *BookingHandler {
-	return &BookingHandler{repo: repo}
+func NewBookingHandler(repo repositories.BookingRepository, members repositories.MemberRepository, policy models.CancellationPolicy) *BookingHandler {
+	return &BookingHandler{repo: repo, members: members, policy: policy}
 }
 
 // CreateBooking godoc
 // @Summary Create a new booking
-// @Description Creates a new booking for a member to attend a class
+// @Description Creates a new booking for a member to attend a class, given by classId and date or by occurrenceId. The member is given by memberId, whose name is used for the booking, or by name alone. When the class is full and waitlist is set, the member is added to the waitlist instead.
 // @Tags bookings
 // @Accept json
 // @Produce json
 // @Param booking body models.BookingInput true "Booking information"
 // @Success 201 {object} responses.Response{data=models.Booking} "Booking created successfully"
-// @Failure 400 {object} responses.Response "Invalid input"
+// @Success 202 {object} responses.Response{data=models.WaitlistEntry} "Class is full, added to waitlist"
+// @Failure 400 {object} responses.Response "Invalid input, member not found or inactive"
+// @Failure 409 {object} responses.Response "Class is full (data is a CapacityInfo) or member already booked (data is a DuplicateBookingInfo)"
 // @Router /bookings [post]
 func (h *BookingHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 	var input models.BookingInput
@@ -40,32 +62,183 @@ func (h *BookingHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if input.MemberID != "" {
+		member, err := activeMember(h.members, input.MemberID)
+		if err != nil {
+			responses.BadRequestResponse(w, err.Error())
+			return
+		}
+		input.Name = member.Name
+	}
+
 	booking, err := models.NewBooking(input)
 	if err != nil {
 		responses.BadRequestResponse(w, err.Error())
 		return
 	}
 
-	if err := h.repo.Create(booking); err != nil {
-		responses.BadRequestResponse(w, err.Error())
+	if input.Waitlist {
+		entry, err := h.repo.CreateOrWaitlist(booking)
+		if err != nil {
+			writeBookingError(w, err)
+			return
+		}
+		if entry != nil {
+			responses.SuccessResponse(w, http.StatusAccepted, "Class is full, added to waitlist", entry)
+			return
+		}
+	} else if err := h.repo.Create(booking); err != nil {
+		writeBookingError(w, err)
 		return
 	}
 
 	responses.CreatedResponse(w, "Booking created successfully", booking)
 }
 
+// writeBookingError maps repository errors to API responses
+func writeBookingError(w http.ResponseWriter, err error) {
+	var fullErr *repositories.ClassFullError
+	if errors.As(err, &fullErr) {
+		responses.ConflictResponse(w, fullErr.Error(), CapacityInfo{
+			ClassID:        fullErr.ClassID,
+			Date:           fullErr.Date.Format("2006-01-02"),
+			Capacity:       fullErr.Capacity,
+			Booked:         fullErr.Booked,
+			RemainingSpots: fullErr.RemainingSpots(),
+		})
+		return
+	}
+
+	var duplicateErr *repositories.DuplicateBookingError
+	if errors.As(err, &duplicateErr) {
+		responses.ConflictResponse(w, duplicateErr.Error(), DuplicateBookingInfo{
+			ExistingBookingID: duplicateErr.ExistingBookingID,
+		})
+		return
+	}
+
+	var transitionErr *models.InvalidTransitionError
+	if errors.As(err, &transitionErr) {
+		responses.ConflictResponse(w, transitionErr.Error(), nil)
+		return
+	}
+
+	responses.BadRequestResponse(w, err.Error())
+}
+
+// activeMember returns the member a booking is made for, who must be active
+func activeMember(members repositories.MemberRepository, id string) (*models.Member, error) {
+	member, err := members.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("member %s not found", id)
+	}
+	if !member.IsActive() {
+		return nil, models.ErrMemberInactive
+	}
+	return member, nil
+}
+
 // GetAllBookings godoc
 // @Summary Get all bookings
-// @Description Retrieves a list of all bookings
+// @Description Retrieves a list of all bookings, optionally filtered by class, occurrence, member, series, status, class date range and creation time range
 // @Tags bookings
 // @Produce json
+// @Param classId query string false "Filter by class ID"
+// @Param occurrenceId query string false "Filter by class occurrence ID"
+// @Param memberId query string false "Filter by member ID"
+// @Param name query string false "Filter by member name (case-insensitive)"
+// @Param seriesId query string false "Filter by booking series ID"
+// @Param status query string false "Comma-separated statuses (pending, confirmed, waitlisted, cancelled, attended, no-show)"
+// @Param dateFrom query string false "Earliest class date (YYYY-MM-DD)"
+// @Param dateTo query string false "Latest class date (YYYY-MM-DD)"
+// @Param createdFrom query string false "Earliest creation time (RFC 3339 or YYYY-MM-DD)"
+// @Param createdTo query string false "Latest creation time (RFC 3339 or YYYY-MM-DD)"
 // @Success 200 {object} responses.Response{data=[]models.Booking} "List of bookings"
+// @Failure 400 {object} responses.Response "Invalid filter"
 // @Router /bookings [get]
 func (h *BookingHandler) GetAllBookings(w http.ResponseWriter, r *http.Request) {
-	bookings := h.repo.GetAll()
+	if len(r.URL.Query()) == 0 {
+		bookings := h.repo.GetAll()
+		responses.ListResponse(w, bookings, len(bookings))
+		return
+	}
+
+	query, err := parseBookingQuery(r)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	bookings := h.repo.Find(query)
 	responses.ListResponse(w, bookings, len(bookings))
 }
 
+// parseBookingQuery builds a repository query from the GET /bookings filters
+func parseBookingQuery(r *http.Request) (repositories.BookingQuery, error) {
+	params := r.URL.Query()
+	query := repositories.BookingQuery{
+		ClassID:  params.Get("classId"),
+		SeriesID: params.Get("seriesId"),
+		MemberID: params.Get("memberId"),
+		Name:     params.Get("name"),
+	}
+
+	if statusParam := params.Get("status"); statusParam != "" {
+		for _, value := range strings.Split(statusParam, ",") {
+			status, err := models.ParseBookingStatus(strings.TrimSpace(value))
+			if err != nil {
+				return query, err
+			}
+			query.Statuses = append(query.Statuses, status)
+		}
+	}
+
+	var err error
+	query.DateFrom, query.DateTo, err = parseDateRangeQuery(r, "dateFrom", "dateTo")
+	if err != nil {
+		return query, err
+	}
+
+	if occurrenceID := params.Get("occurrenceId"); occurrenceID != "" {
+		classID, date, err := models.ParseOccurrenceID(occurrenceID)
+		if err != nil {
+			return query, err
+		}
+		query.ClassID, query.DateFrom, query.DateTo = classID, date, date
+	}
+
+	if query.CreatedFrom, err = parseTimeQuery(r, "createdFrom", false); err != nil {
+		return query, err
+	}
+	if query.CreatedTo, err = parseTimeQuery(r, "createdTo", true); err != nil {
+		return query, err
+	}
+
+	return query, nil
+}
+
+// parseTimeQuery reads an optional RFC 3339 or YYYY-MM-DD query parameter. A
+// plain date used as an upper bound covers the whole day.
+func parseTimeQuery(r *http.Request, param string, endOfDay bool) (time.Time, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	date, err := models.ParseDate(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s format. Use RFC 3339 or YYYY-MM-DD", param)
+	}
+	if endOfDay {
+		return date.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return date, nil
+}
+
 // GetBookingByID godoc
 // @Summary Get booking by ID
 // @Description Retrieves a booking by its ID
@@ -87,3 +260,244 @@ func (h *BookingHandler) GetBookingByID(w http.ResponseWriter, r *http.Request)
 
 	responses.OKResponse(w, booking)
 }
+
+// CancelBooking godoc
+// @Summary Cancel a booking
+// @Description Cancels a booking according to the studio cancellation policy. Cancellations inside the free cancellation window are flagged as late, or rejected if the policy does not allow them.
+// @Tags bookings
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param cancelledBy query string false "Who cancelled the booking"
+// @Param reason query string false "Reason for the cancellation"
+// @Success 200 {object} responses.Response{data=models.Booking} "Booking cancelled"
+// @Failure 404 {object} responses.Response "Booking not found"
+// @Failure 409 {object} responses.Response "Booking cannot be cancelled"
+// @Router /bookings/{id} [delete]
+func (h *BookingHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	booking, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking not found")
+		return
+	}
+
+	query := r.URL.Query()
+	cancellation, err := h.policy.Apply(booking, query.Get("cancelledBy"), query.Get("reason"), time.Now())
+	if err != nil {
+		responses.ConflictResponse(w, err.Error(), nil)
+		return
+	}
+
+	booking, err = h.repo.Cancel(id, cancellation)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBookingNotFound) {
+			responses.NotFoundResponse(w, "Booking not found")
+			return
+		}
+		responses.ConflictResponse(w, err.Error(), nil)
+		return
+	}
+
+	message := "Booking cancelled"
+	if cancellation.Late {
+		message = "Booking cancelled (late cancellation)"
+	}
+	responses.SuccessResponse(w, http.StatusOK, message, booking)
+}
+
+// RescheduleBooking godoc
+// @Summary Reschedule a booking
+// @Description Moves a confirmed booking to another date and/or class in one step. The original booking is kept if the target class date is unavailable or full.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param reschedule body models.RescheduleInput true "Target date and optional class"
+// @Success 200 {object} responses.Response{data=models.Booking} "Booking rescheduled"
+// @Failure 400 {object} responses.Response "Invalid input or no class on the requested date"
+// @Failure 404 {object} responses.Response "Booking not found"
+// @Failure 409 {object} responses.Response "Target is full, member already booked there or booking cannot be rescheduled"
+// @Router /bookings/{id}/reschedule [post]
+func (h *BookingHandler) RescheduleBooking(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	var input models.RescheduleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+	if err := input.Validate(); err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	classID := input.ClassID
+	if classID == "" {
+		current, err := h.repo.GetByID(id)
+		if err != nil {
+			responses.NotFoundResponse(w, "Booking not found")
+			return
+		}
+		classID = current.ClassID
+	}
+
+	date, start, _ := models.ParseSessionRequest(input.Date)
+	booking, err := h.repo.Reschedule(id, classID, date, start)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrBookingNotFound):
+			responses.NotFoundResponse(w, "Booking not found")
+		case errors.Is(err, models.ErrBookingNotReschedulable), errors.Is(err, models.ErrRescheduleToSameSlot):
+			responses.ConflictResponse(w, err.Error(), nil)
+		default:
+			writeBookingError(w, err)
+		}
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Booking rescheduled", booking)
+}
+
+// UpdateBookingStatus godoc
+// @Summary Update booking status
+// @Description Moves a booking to a new status, e.g. to mark it attended or no-show. A booking can be marked attended from the day of the class, as with check-in. Cancellations go through DELETE /bookings/{id} so the cancellation policy applies, and waitlisted bookings are confirmed only from the waitlist.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Param status body models.BookingStatusInput true "New status"
+// @Success 200 {object} responses.Response{data=models.Booking} "Booking status updated"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 404 {object} responses.Response "Booking not found"
+// @Failure 409 {object} responses.Response "Transition not allowed or check-in not open yet"
+// @Router /bookings/{id}/status [patch]
+func (h *BookingHandler) UpdateBookingStatus(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	var input models.BookingStatusInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	status, err := models.ParseBookingStatus(input.Status)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	switch status {
+	case models.BookingStatusCancelled:
+		responses.BadRequestResponse(w, "use DELETE /bookings/{id} to cancel a booking")
+		return
+	case models.BookingStatusWaitlisted:
+		responses.BadRequestResponse(w, "bookings are waitlisted automatically when a class is full")
+		return
+	case models.BookingStatusAttended:
+		// Attendance follows the same rules as checking in
+		booking, err := h.repo.GetByID(id)
+		if err != nil {
+			responses.NotFoundResponse(w, "Booking not found")
+			return
+		}
+		if err := booking.CanCheckIn(time.Now()); err != nil {
+			responses.ConflictResponse(w, err.Error(), nil)
+			return
+		}
+	}
+
+	booking, err := h.repo.UpdateStatus(id, status)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBookingNotFound) {
+			responses.NotFoundResponse(w, "Booking not found")
+			return
+		}
+		writeBookingError(w, err)
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Booking status updated", booking)
+}
+
+// GetWaitlist godoc
+// @Summary Get the waitlist for a class date
+// @Description Retrieves the members waiting for a spot on a class date, in queue order
+// @Tags waitlist
+// @Produce json
+// @Param classId query string true "Class ID"
+// @Param date query string true "Class date (YYYY-MM-DD)"
+// @Success 200 {object} responses.Response{data=[]models.WaitlistEntry} "Waitlist entries"
+// @Failure 400 {object} responses.Response "Invalid query"
+// @Router /waitlist [get]
+func (h *BookingHandler) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	classID, date, err := parseClassDateQuery(r)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	entries := h.repo.GetWaitlist(classID, date)
+	responses.ListResponse(w, entries, len(entries))
+}
+
+// GetWaitlistEntry godoc
+// @Summary Get waitlist entry by ID
+// @Description Retrieves a waitlist entry with its current position, or its promotion details once promoted
+// @Tags waitlist
+// @Produce json
+// @Param id path string true "Waitlist entry ID"
+// @Success 200 {object} responses.Response{data=models.WaitlistEntry} "Waitlist entry found"
+// @Failure 404 {object} responses.Response "Waitlist entry not found"
+// @Router /waitlist/{id} [get]
+func (h *BookingHandler) GetWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	entry, err := h.repo.GetWaitlistEntry(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Waitlist entry not found")
+		return
+	}
+
+	responses.OKResponse(w, entry)
+}
+
+// GetPromotions godoc
+// @Summary Get waitlist promotion history
+// @Description Retrieves the waitlist entries promoted to bookings for a class date, oldest first
+// @Tags waitlist
+// @Produce json
+// @Param classId query string true "Class ID"
+// @Param date query string true "Class date (YYYY-MM-DD)"
+// @Success 200 {object} responses.Response{data=[]models.WaitlistEntry} "Promoted entries"
+// @Failure 400 {object} responses.Response "Invalid query"
+// @Router /waitlist/promotions [get]
+func (h *BookingHandler) GetPromotions(w http.ResponseWriter, r *http.Request) {
+	classID, date, err := parseClassDateQuery(r)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	promotions := h.repo.GetPromotions(classID, date)
+	responses.ListResponse(w, promotions, len(promotions))
+}
+
+// parseClassDateQuery reads the required classId and date query parameters
+func parseClassDateQuery(r *http.Request) (string, time.Time, error) {
+	classID := r.URL.Query().Get("classId")
+	if classID == "" {
+		return "", time.Time{}, errors.New("classId is required")
+	}
+
+	date, err := models.ParseDate(r.URL.Query().Get("date"))
+	if err != nil {
+		return "", time.Time{}, errors.New("invalid date format. Use YYYY-MM-DD")
+	}
+
+	return classID, date, nil
+}
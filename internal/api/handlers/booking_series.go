@@ -0,0 +1,254 @@
+// File: internal/api/handlers/booking_series.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// BookingSeriesHandler handles HTTP requests related to recurring booking series
+type BookingSeriesHandler struct {
+	repo        repositories.BookingSeriesRepository
+	bookingRepo repositories.BookingRepository
+	members     repositories.MemberRepository
+	policy      models.CancellationPolicy
+}
+
+// SeriesCancellationResult lists the bookings cancelled with a series and the
+// ones that could not be cancelled
+type SeriesCancellationResult struct {
+	Series    *models.BookingSeries  `json:"series"`
+	Cancelled []*models.Booking      `json:"cancelled"`
+	Failures  []models.SeriesFailure `json:"failures"`
+}
+
+// NewBookingSeriesHandler creates a new BookingSeriesHandler instance
+func NewBookingSeriesHandler(repo repositories.BookingSeriesRepository, bookingRepo repositories.BookingRepository, members repositories.MemberRepository, policy models.CancellationPolicy) *BookingSeriesHandler {
+	return &BookingSeriesHandler{repo: repo, bookingRepo: bookingRepo, members: members, policy: policy}
+}
+
+// CreateBookingSeries godoc
+// @Summary Create a recurring booking series
+// @Description Books a class on every matching weekday of a weekly pattern, e.g. every Tuesday for the next 8 weeks. The member is given by memberId, whose name is used for the bookings, or by name alone. Dates that cannot be booked are reported in failures.
+// @Tags booking-series
+// @Accept json
+// @Produce json
+// @Param series body models.BookingSeriesInput true "Series information"
+// @Success 201 {object} responses.Response{data=models.BookingSeries} "Series created"
+// @Failure 400 {object} responses.Response "Invalid input, class or member not found or member inactive"
+// @Failure 409 {object} responses.Response{data=models.BookingSeries} "No date of the series could be booked"
+// @Router /booking-series [post]
+func (h *BookingSeriesHandler) CreateBookingSeries(w http.ResponseWriter, r *http.Request) {
+	var input models.BookingSeriesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	if input.MemberID != "" {
+		member, err := activeMember(h.members, input.MemberID)
+		if err != nil {
+			responses.BadRequestResponse(w, err.Error())
+			return
+		}
+		input.Name = member.Name
+	}
+
+	series, err := models.NewBookingSeries(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	// The series is stored before its bookings so none of them refers to a
+	// series that does not exist
+	if err := h.repo.Create(series); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	for _, date := range series.Dates() {
+		booking, err := series.NewBooking(date)
+		if err != nil {
+			h.discard(series)
+			responses.BadRequestResponse(w, err.Error())
+			return
+		}
+
+		if err := h.bookingRepo.Create(booking); err != nil {
+			if errors.Is(err, repositories.ErrClassNotFound) && len(series.BookingIDs) == 0 {
+				h.discard(series)
+				responses.BadRequestResponse(w, err.Error())
+				return
+			}
+			series.Failures = append(series.Failures, models.SeriesFailure{
+				Date:    date,
+				Reason:  seriesFailureReason(err),
+				Message: err.Error(),
+			})
+			continue
+		}
+		series.BookingIDs = append(series.BookingIDs, booking.ID)
+	}
+
+	if len(series.BookingIDs) == 0 {
+		h.discard(series)
+		responses.ConflictResponse(w, "no date of the series could be booked", series)
+		return
+	}
+
+	if err := h.repo.Update(series); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	responses.CreatedResponse(w, "Booking series created successfully", series)
+}
+
+// GetAllBookingSeries godoc
+// @Summary Get all booking series
+// @Description Retrieves a list of all recurring booking series
+// @Tags booking-series
+// @Produce json
+// @Success 200 {object} responses.Response{data=[]models.BookingSeries} "List of booking series"
+// @Router /booking-series [get]
+func (h *BookingSeriesHandler) GetAllBookingSeries(w http.ResponseWriter, r *http.Request) {
+	series := h.repo.GetAll()
+	responses.ListResponse(w, series, len(series))
+}
+
+// GetBookingSeriesByID godoc
+// @Summary Get booking series by ID
+// @Description Retrieves a recurring booking series by its ID
+// @Tags booking-series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Success 200 {object} responses.Response{data=models.BookingSeries} "Series found"
+// @Failure 404 {object} responses.Response "Series not found"
+// @Router /booking-series/{id} [get]
+func (h *BookingSeriesHandler) GetBookingSeriesByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	series, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking series not found")
+		return
+	}
+
+	responses.OKResponse(w, series)
+}
+
+// CancelBookingSeries godoc
+// @Summary Cancel a booking series
+// @Description Cancels every booking of a series (scope=all) or only the ones from today onwards (scope=remaining). Each booking is cancelled according to the studio cancellation policy.
+// @Tags booking-series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param scope query string false "all or remaining (default remaining)"
+// @Param cancelledBy query string false "Who cancelled the series"
+// @Param reason query string false "Reason for the cancellation"
+// @Success 200 {object} responses.Response{data=SeriesCancellationResult} "Series cancelled"
+// @Failure 400 {object} responses.Response "Invalid scope"
+// @Failure 404 {object} responses.Response "Series not found"
+// @Router /booking-series/{id} [delete]
+func (h *BookingSeriesHandler) CancelBookingSeries(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	query := r.URL.Query()
+	scope := query.Get("scope")
+	if scope == "" {
+		scope = "remaining"
+	}
+	if scope != "all" && scope != "remaining" {
+		responses.BadRequestResponse(w, "scope must be all or remaining")
+		return
+	}
+
+	series, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking series not found")
+		return
+	}
+
+	now := time.Now()
+	today := models.DateOf(now)
+	result := SeriesCancellationResult{
+		Cancelled: make([]*models.Booking, 0),
+		Failures:  make([]models.SeriesFailure, 0),
+	}
+
+	for _, bookingID := range series.BookingIDs {
+		booking, err := h.bookingRepo.GetByID(bookingID)
+		if err != nil || booking.IsCancelled() {
+			continue
+		}
+		if scope == "remaining" && booking.Date.Before(today) {
+			continue
+		}
+
+		cancelled, err := h.cancel(booking, query.Get("cancelledBy"), query.Get("reason"), now)
+		if err != nil {
+			result.Failures = append(result.Failures, models.SeriesFailure{
+				Date:      booking.Date,
+				BookingID: bookingID,
+				Reason:    models.SeriesFailureOther,
+				Message:   err.Error(),
+			})
+			continue
+		}
+		result.Cancelled = append(result.Cancelled, cancelled)
+	}
+
+	series, err = h.repo.MarkCancelled(id, now)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking series not found")
+		return
+	}
+	result.Series = series
+
+	responses.SuccessResponse(w, http.StatusOK, "Booking series cancelled", result)
+}
+
+// cancel cancels one booking of a series according to the cancellation policy
+// discard removes a series none of whose dates was booked
+func (h *BookingSeriesHandler) discard(series *models.BookingSeries) {
+	_ = h.repo.Delete(series.ID)
+}
+
+func (h *BookingSeriesHandler) cancel(booking *models.Booking, cancelledBy, reason string, now time.Time) (*models.Booking, error) {
+	cancellation, err := h.policy.Apply(booking, cancelledBy, reason, now)
+	if err != nil {
+		return nil, err
+	}
+	return h.bookingRepo.Cancel(booking.ID, cancellation)
+}
+
+// seriesFailureReason classifies why a date of a series could not be booked
+func seriesFailureReason(err error) string {
+	var fullErr *repositories.ClassFullError
+	var duplicateErr *repositories.DuplicateBookingError
+	var closedErr *repositories.StudioClosedError
+
+	switch {
+	case errors.As(err, &fullErr):
+		return models.SeriesFailureFull
+	case errors.Is(err, repositories.ErrDateOutOfRange), errors.Is(err, models.ErrOccurrenceCancelled):
+		return models.SeriesFailureOutOfRange
+	case errors.As(err, &duplicateErr):
+		return models.SeriesFailureDuplicate
+	case errors.As(err, &closedErr):
+		return models.SeriesFailureClosed
+	}
+	return models.SeriesFailureOther
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateBookingSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := mocks.NewMockBookingSeriesRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingSeriesHandler(mockSeriesRepo, mockBookingRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	seriesInput := models.BookingSeriesInput{
+		Name:      "John Doe",
+		ClassID:   "test-class-id",
+		StartDate: "2022-01-04",
+		Weekdays:  []string{"tuesday"},
+		Weeks:     3,
+	}
+	requestBody, _ := json.Marshal(seriesInput)
+
+	gomock.InOrder(
+		mockSeriesRepo.EXPECT().Create(gomock.Any()).Return(nil),
+		mockBookingRepo.EXPECT().Create(gomock.Any()).Return(nil),
+		mockBookingRepo.EXPECT().Create(gomock.Any()).Return(&repositories.ClassFullError{Capacity: 10, Booked: 10}),
+		mockBookingRepo.EXPECT().Create(gomock.Any()).Return(repositories.ErrDateOutOfRange),
+		mockSeriesRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(series *models.BookingSeries) error {
+			assert.Len(t, series.BookingIDs, 1)
+			assert.Len(t, series.Failures, 2)
+			return nil
+		}),
+	)
+
+	req := httptest.NewRequest("POST", "/booking-series", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBookingSeries(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var response struct {
+		Data models.BookingSeries `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data.BookingIDs, 1)
+	assert.Len(t, response.Data.Failures, 2)
+	assert.Equal(t, models.SeriesFailureFull, response.Data.Failures[0].Reason)
+	assert.Equal(t, "2022-01-11", response.Data.Failures[0].Date.Format("2006-01-02"))
+	assert.Equal(t, models.SeriesFailureOutOfRange, response.Data.Failures[1].Reason)
+}
+
+func TestCreateBookingSeries_Member(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := mocks.NewMockBookingSeriesRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	mockMembers := mocks.NewMockMemberRepository(ctrl)
+	handler := NewBookingSeriesHandler(mockSeriesRepo, mockBookingRepo, mockMembers, models.DefaultCancellationPolicy())
+
+	seriesInput := models.BookingSeriesInput{
+		MemberID:  "member-1",
+		ClassID:   "test-class-id",
+		StartDate: "2022-01-04",
+		Weekdays:  []string{"tuesday"},
+		Weeks:     2,
+	}
+	requestBody, _ := json.Marshal(seriesInput)
+
+	mockMembers.EXPECT().GetByID("member-1").Return(&models.Member{ID: "member-1", Name: "John Doe", Status: models.MemberStatusActive}, nil)
+	mockBookingRepo.EXPECT().Create(gomock.Any()).Times(2).DoAndReturn(func(booking *models.Booking) error {
+		assert.Equal(t, "member-1", booking.MemberID)
+		assert.Equal(t, "John Doe", booking.Name)
+		return nil
+	})
+	mockSeriesRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	mockSeriesRepo.EXPECT().Update(gomock.Any()).Return(nil)
+
+	req := httptest.NewRequest("POST", "/booking-series", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBookingSeries(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"memberId":"member-1"`)
+}
+
+func TestCreateBookingSeries_ClassNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := mocks.NewMockBookingSeriesRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingSeriesHandler(mockSeriesRepo, mockBookingRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	seriesInput := models.BookingSeriesInput{
+		Name:      "John Doe",
+		ClassID:   "missing-class-id",
+		StartDate: "2022-01-04",
+		Weekdays:  []string{"tuesday"},
+		Weeks:     3,
+	}
+	requestBody, _ := json.Marshal(seriesInput)
+
+	var seriesID string
+	gomock.InOrder(
+		mockSeriesRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(series *models.BookingSeries) error {
+			seriesID = series.ID
+			return nil
+		}),
+		mockBookingRepo.EXPECT().Create(gomock.Any()).Return(repositories.ErrClassNotFound),
+		// The series stored for the bookings is removed again
+		mockSeriesRepo.EXPECT().Delete(gomock.Any()).DoAndReturn(func(id string) error {
+			assert.Equal(t, seriesID, id)
+			return nil
+		}),
+	)
+
+	req := httptest.NewRequest("POST", "/booking-series", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBookingSeries(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateBookingSeries_InvalidWeekday(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := mocks.NewMockBookingSeriesRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingSeriesHandler(mockSeriesRepo, mockBookingRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	seriesInput := models.BookingSeriesInput{
+		Name:      "John Doe",
+		ClassID:   "test-class-id",
+		StartDate: "2022-01-04",
+		Weekdays:  []string{"someday"},
+		Weeks:     3,
+	}
+	requestBody, _ := json.Marshal(seriesInput)
+
+	req := httptest.NewRequest("POST", "/booking-series", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBookingSeries(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCancelBookingSeries_Remaining(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeriesRepo := mocks.NewMockBookingSeriesRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingSeriesHandler(mockSeriesRepo, mockBookingRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	series := &models.BookingSeries{ID: "series-id", BookingIDs: []string{"past", "future"}}
+	past := &models.Booking{ID: "past", Date: time.Now().AddDate(0, 0, -7), Status: models.BookingStatusAttended}
+	future := &models.Booking{ID: "future", Date: time.Now().AddDate(0, 0, 7), Status: models.BookingStatusConfirmed}
+
+	mockSeriesRepo.EXPECT().GetByID("series-id").Return(series, nil)
+	mockBookingRepo.EXPECT().GetByID("past").Return(past, nil)
+	mockBookingRepo.EXPECT().GetByID("future").Return(future, nil)
+	mockBookingRepo.EXPECT().Cancel("future", gomock.Any()).Return(future, nil)
+	mockSeriesRepo.EXPECT().MarkCancelled("series-id", gomock.Any()).Return(series, nil)
+
+	req := httptest.NewRequest("DELETE", "/booking-series/series-id?scope=remaining", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "series-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.CancelBookingSeries(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data SeriesCancellationResult `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data.Cancelled, 1)
+	assert.Empty(t, response.Data.Failures)
+}
@@ -10,8 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"glofox-backend/internal/api/responses"
 	"glofox-backend/internal/mocks"
 	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -23,7 +25,7 @@ func TestCreateBooking(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBookingRepository(ctrl)
-	handler := NewBookingHandler(mockRepo)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
 
 	bookingInput := models.BookingInput{
 		Name:    "John Doe",
@@ -43,12 +45,220 @@ func TestCreateBooking(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, recorder.Code)
 }
 
+func TestCreateBooking_Member(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	mockMembers := mocks.NewMockMemberRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mockMembers, models.DefaultCancellationPolicy())
+
+	mockMembers.EXPECT().GetByID("member-1").Return(&models.Member{ID: "member-1", Name: "John Doe", Status: models.MemberStatusActive}, nil)
+	mockRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(booking *models.Booking) error {
+		assert.Equal(t, "member-1", booking.MemberID)
+		assert.Equal(t, "John Doe", booking.Name)
+		return nil
+	})
+
+	requestBody, _ := json.Marshal(models.BookingInput{
+		MemberID: "member-1",
+		Date:     "2022-01-05",
+		ClassID:  "test-class-id",
+	})
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestCreateBooking_InactiveMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMembers := mocks.NewMockMemberRepository(ctrl)
+	handler := NewBookingHandler(mocks.NewMockBookingRepository(ctrl), mockMembers, models.DefaultCancellationPolicy())
+
+	mockMembers.EXPECT().GetByID("member-1").Return(&models.Member{ID: "member-1", Name: "John Doe", Status: models.MemberStatusInactive}, nil)
+
+	requestBody, _ := json.Marshal(models.BookingInput{
+		MemberID: "member-1",
+		Date:     "2022-01-05",
+		ClassID:  "test-class-id",
+	})
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateBooking_StudioTimezone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	location, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	models.SetStudioLocation(location)
+	defer models.SetStudioLocation(time.UTC)
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	// 22:30 in New York is already the next day in UTC
+	requestBody, _ := json.Marshal(models.BookingInput{
+		Name:    "John Doe",
+		Date:    "2022-01-05T22:30:00-05:00",
+		ClassID: "test-class-id",
+	})
+
+	mockRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(booking *models.Booking) error {
+		assert.Equal(t, time.Date(2022, 1, 5, 0, 0, 0, 0, location), booking.Date)
+		assert.Equal(t, "test-class-id:2022-01-05", booking.OccurrenceID)
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "2022-01-05T00:00:00-05:00")
+}
+
+func TestCreateBooking_Occurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	requestBody, _ := json.Marshal(models.BookingInput{
+		Name:         "John Doe",
+		OccurrenceID: "test-class-id:2022-01-05",
+	})
+
+	mockRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(booking *models.Booking) error {
+		assert.Equal(t, "test-class-id", booking.ClassID)
+		assert.Equal(t, time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC), booking.Date)
+		assert.Equal(t, "test-class-id:2022-01-05", booking.OccurrenceID)
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestCreateBooking_SessionStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	requestBody, _ := json.Marshal(models.BookingInput{
+		Name:    "John Doe",
+		Date:    "2022-01-05T18:00:00+01:00",
+		ClassID: "test-class-id",
+	})
+
+	mockRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(booking *models.Booking) error {
+		assert.Equal(t, time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC), booking.Date)
+		assert.Equal(t, time.Date(2022, 1, 5, 17, 0, 0, 0, time.UTC), booking.Session.Start)
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestCreateBooking_ClassFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	bookingInput := models.BookingInput{
+		Name:    "John Doe",
+		Date:    "2022-01-05",
+		ClassID: "test-class-id",
+	}
+	requestBody, _ := json.Marshal(bookingInput)
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(&repositories.ClassFullError{
+		ClassID:  "test-class-id",
+		Date:     time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC),
+		Capacity: 15,
+		Booked:   15,
+	})
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var response struct {
+		responses.Response
+		Data CapacityInfo `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.False(t, response.Success)
+	assert.Equal(t, 15, response.Data.Capacity)
+	assert.Equal(t, 0, response.Data.RemainingSpots)
+}
+
+func TestCreateBooking_Duplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	bookingInput := models.BookingInput{
+		Name:    "John Doe",
+		Date:    "2022-01-05",
+		ClassID: "test-class-id",
+	}
+	requestBody, _ := json.Marshal(bookingInput)
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(&repositories.DuplicateBookingError{ExistingBookingID: "existing-id"})
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+
+	var response struct {
+		Data DuplicateBookingInfo `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "existing-id", response.Data.ExistingBookingID)
+}
+
 func TestGetBookingByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBookingRepository(ctrl)
-	handler := NewBookingHandler(mockRepo)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
 
 	mockBooking := &models.Booking{
 		ID:        "test-id",
@@ -74,7 +284,7 @@ func TestGetBookingByID_NotFound(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBookingRepository(ctrl)
-	handler := NewBookingHandler(mockRepo)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
 
 	mockRepo.EXPECT().GetByID("non-existent-id").Return(nil, errors.New("booking not found"))
 
@@ -92,7 +302,7 @@ func TestGetAllBookings(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockBookingRepository(ctrl)
-	handler := NewBookingHandler(mockRepo)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
 
 	mockBookings := []*models.Booking{
 		{ID: "test-id-1", Name: "John", Date: time.Now(), ClassID: "1", CreatedAt: time.Now()},
@@ -108,3 +318,309 @@ func TestGetAllBookings(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, recorder.Code)
 }
+
+func TestCreateBooking_Waitlisted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	bookingInput := models.BookingInput{
+		Name:     "John Doe",
+		Date:     "2022-01-05",
+		ClassID:  "test-class-id",
+		Waitlist: true,
+	}
+	requestBody, _ := json.Marshal(bookingInput)
+
+	mockRepo.EXPECT().CreateOrWaitlist(gomock.Any()).Return(&models.WaitlistEntry{
+		ID:       "entry-id",
+		Name:     "John Doe",
+		ClassID:  "test-class-id",
+		Position: 3,
+	}, nil)
+
+	req := httptest.NewRequest("POST", "/bookings", bytes.NewBuffer(requestBody))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+
+	handler.CreateBooking(recorder, req)
+
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+
+	var response struct {
+		Data models.WaitlistEntry `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.Data.Position)
+}
+
+func TestGetWaitlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	date := time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)
+	mockEntries := []*models.WaitlistEntry{
+		{ID: "entry-1", Name: "John", ClassID: "1", Date: date, Position: 1},
+		{ID: "entry-2", Name: "Jane", ClassID: "1", Date: date, Position: 2},
+	}
+
+	mockRepo.EXPECT().GetWaitlist("1", date).Return(mockEntries)
+
+	req := httptest.NewRequest("GET", "/waitlist?classId=1&date=2022-01-05", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetWaitlist(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGetWaitlist_MissingClassID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	req := httptest.NewRequest("GET", "/waitlist?date=2022-01-05", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetWaitlist(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCancelBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	mockBooking := &models.Booking{
+		ID:        "test-id",
+		Name:      "John Doe",
+		Date:      time.Now().AddDate(0, 0, 7),
+		ClassID:   "test-class-id",
+		CreatedAt: time.Now(),
+		Status:    models.BookingStatusConfirmed,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockBooking, nil)
+	mockRepo.EXPECT().Cancel("test-id", gomock.Any()).DoAndReturn(
+		func(id string, cancellation *models.Cancellation) (*models.Booking, error) {
+			assert.Equal(t, "front-desk", cancellation.CancelledBy)
+			assert.False(t, cancellation.Late)
+			mockBooking.Cancellation = cancellation
+			return mockBooking, nil
+		})
+
+	req := httptest.NewRequest("DELETE", "/bookings/test-id?cancelledBy=front-desk", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.CancelBooking(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestCancelBooking_LateNotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	policy := models.CancellationPolicy{FreeCancellationWindow: 48 * time.Hour}
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), policy)
+
+	mockBooking := &models.Booking{
+		ID:        "test-id",
+		Name:      "John Doe",
+		Date:      time.Now().Add(time.Hour),
+		ClassID:   "test-class-id",
+		CreatedAt: time.Now(),
+		Status:    models.BookingStatusConfirmed,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockBooking, nil)
+
+	req := httptest.NewRequest("DELETE", "/bookings/test-id", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.CancelBooking(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestGetAllBookings_StatusFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	mockBookings := []*models.Booking{
+		{ID: "test-id-1", Name: "John", Date: time.Now(), ClassID: "1", Status: models.BookingStatusAttended},
+	}
+
+	mockRepo.EXPECT().Find(repositories.BookingQuery{
+		Statuses: []models.BookingStatus{models.BookingStatusAttended, models.BookingStatusNoShow},
+	}).Return(mockBookings)
+
+	req := httptest.NewRequest("GET", "/bookings?status=attended,no-show", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllBookings(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGetAllBookings_Filters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	mockRepo.EXPECT().Find(repositories.BookingQuery{
+		ClassID:     "1",
+		Name:        "john doe",
+		DateFrom:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:      time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC),
+		CreatedFrom: time.Date(2021, 12, 1, 9, 30, 0, 0, time.UTC),
+		CreatedTo:   time.Date(2021, 12, 31, 23, 59, 59, 999999999, time.UTC),
+	}).Return([]*models.Booking{})
+
+	req := httptest.NewRequest("GET", "/bookings?classId=1&name=john+doe&dateFrom=2022-01-01&dateTo=2022-01-31&createdFrom=2021-12-01T09:30:00Z&createdTo=2021-12-31", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllBookings(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGetAllBookings_InvalidDateRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	req := httptest.NewRequest("GET", "/bookings?dateFrom=2022-02-01&dateTo=2022-01-01", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllBookings(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGetAllBookings_InvalidStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	req := httptest.NewRequest("GET", "/bookings?status=finished", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllBookings(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateBookingStatus_InvalidTransition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	requestBody, _ := json.Marshal(models.BookingStatusInput{Status: "attended"})
+
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Booking{ID: "test-id", Status: models.BookingStatusCancelled}, nil)
+
+	req := httptest.NewRequest("PATCH", "/bookings/test-id/status", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateBookingStatus(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestUpdateBookingStatus_AttendedBeforeClassDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	requestBody, _ := json.Marshal(models.BookingStatusInput{Status: "attended"})
+
+	tomorrow := models.DateOf(time.Now()).AddDate(0, 0, 1)
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Booking{ID: "test-id", Date: tomorrow, Status: models.BookingStatusConfirmed}, nil)
+
+	req := httptest.NewRequest("PATCH", "/bookings/test-id/status", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateBookingStatus(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), models.ErrCheckInNotOpen.Error())
+}
+
+func TestRescheduleBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	date := time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC)
+	mockBooking := &models.Booking{ID: "test-id", Name: "John", Date: date, ClassID: "other-class", Status: models.BookingStatusConfirmed}
+
+	mockRepo.EXPECT().Reschedule("test-id", "other-class", date, time.Time{}).Return(mockBooking, nil)
+
+	requestBody, _ := json.Marshal(models.RescheduleInput{Date: "2022-01-07", ClassID: "other-class"})
+	req := httptest.NewRequest("POST", "/bookings/test-id/reschedule", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.RescheduleBooking(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestRescheduleBooking_TargetFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewBookingHandler(mockRepo, mocks.NewMockMemberRepository(ctrl), models.DefaultCancellationPolicy())
+
+	date := time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC)
+	current := &models.Booking{ID: "test-id", Name: "John", Date: time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC), ClassID: "test-class-id"}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(current, nil)
+	mockRepo.EXPECT().Reschedule("test-id", "test-class-id", date, time.Time{}).Return(nil, &repositories.ClassFullError{
+		ClassID:  "test-class-id",
+		Date:     date,
+		Capacity: 10,
+		Booked:   10,
+	})
+
+	requestBody, _ := json.Marshal(models.RescheduleInput{Date: "2022-01-07"})
+	req := httptest.NewRequest("POST", "/bookings/test-id/reschedule", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.RescheduleBooking(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
@@ -0,0 +1,196 @@
+// File: internal/api/handlers/checkin.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/checkin"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// CheckInHandler handles HTTP requests related to member check-in
+type CheckInHandler struct {
+	repo   repositories.BookingRepository
+	signer *checkin.TokenSigner
+}
+
+// RosterCheckInResult reports the outcome of checking in one booking on a roster
+type RosterCheckInResult struct {
+	BookingID string               `json:"bookingId"`
+	Name      string               `json:"name,omitempty"`
+	Status    models.BookingStatus `json:"status,omitempty"`
+	Error     string               `json:"error,omitempty"`
+}
+
+// NewCheckInHandler creates a new CheckInHandler instance
+func NewCheckInHandler(repo repositories.BookingRepository, signer *checkin.TokenSigner) *CheckInHandler {
+	return &CheckInHandler{repo: repo, signer: signer}
+}
+
+// CheckInBooking godoc
+// @Summary Check in a booking
+// @Description Marks the member of a booking as attended. Check-in opens on the day of the class.
+// @Tags check-in
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Success 200 {object} responses.Response{data=models.Booking} "Member checked in"
+// @Failure 404 {object} responses.Response "Booking not found"
+// @Failure 409 {object} responses.Response "Booking cannot be checked in"
+// @Router /bookings/{id}/check-in [post]
+func (h *CheckInHandler) CheckInBooking(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	h.checkIn(w, vars["id"])
+}
+
+// IssueCheckInToken godoc
+// @Summary Issue a check-in token
+// @Description Issues a signed, time-limited token for a confirmed booking that the member can present as a QR code
+// @Tags check-in
+// @Produce json
+// @Param id path string true "Booking ID"
+// @Success 200 {object} responses.Response{data=checkin.Token} "Check-in token"
+// @Failure 404 {object} responses.Response "Booking not found"
+// @Failure 409 {object} responses.Response "Booking is not confirmed"
+// @Router /bookings/{id}/check-in-token [get]
+func (h *CheckInHandler) IssueCheckInToken(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	booking, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking not found")
+		return
+	}
+
+	if booking.Status != models.BookingStatusConfirmed {
+		responses.ConflictResponse(w, "check-in tokens are only issued for confirmed bookings", nil)
+		return
+	}
+
+	responses.OKResponse(w, h.signer.Issue(booking.ID, time.Now()))
+}
+
+// CheckInWithToken godoc
+// @Summary Check in with a token
+// @Description Verifies a check-in token presented by a member and marks the booking as attended
+// @Tags check-in
+// @Accept json
+// @Produce json
+// @Param token body models.CheckInInput true "Check-in token"
+// @Success 200 {object} responses.Response{data=models.Booking} "Member checked in"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 401 {object} responses.Response "Invalid or expired token"
+// @Failure 409 {object} responses.Response "Booking cannot be checked in"
+// @Router /check-in [post]
+func (h *CheckInHandler) CheckInWithToken(w http.ResponseWriter, r *http.Request) {
+	var input models.CheckInInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+	if input.Token == "" {
+		responses.BadRequestResponse(w, "token is required")
+		return
+	}
+
+	bookingID, err := h.signer.Verify(input.Token, time.Now())
+	if err != nil {
+		responses.ErrorResponse(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	h.checkIn(w, bookingID)
+}
+
+// CheckInRoster godoc
+// @Summary Check in a class roster
+// @Description Marks the bookings on a class date as attended, either all of them or the listed booking IDs
+// @Tags check-in
+// @Accept json
+// @Produce json
+// @Param roster body models.RosterCheckInInput true "Roster check-in"
+// @Success 200 {object} responses.Response{data=[]RosterCheckInResult} "Per-booking check-in results"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Router /check-in/roster [post]
+func (h *CheckInHandler) CheckInRoster(w http.ResponseWriter, r *http.Request) {
+	var input models.RosterCheckInInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+	if err := input.Validate(); err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	date, _ := models.ParseDate(input.Date)
+	roster := h.repo.GetByClassAndDate(input.ClassID, date)
+
+	selected := make(map[string]bool, len(input.BookingIDs))
+	for _, id := range input.BookingIDs {
+		selected[id] = true
+	}
+
+	now := time.Now()
+	results := make([]RosterCheckInResult, 0, len(roster))
+	for _, booking := range roster {
+		if len(selected) > 0 && !selected[booking.ID] {
+			continue
+		}
+		delete(selected, booking.ID)
+
+		result := RosterCheckInResult{BookingID: booking.ID, Name: booking.Name}
+		if booking.Status == models.BookingStatusAttended {
+			result.Status = booking.Status
+		} else if err := booking.CanCheckIn(now); err != nil {
+			result.Status = booking.Status
+			result.Error = err.Error()
+		} else if updated, err := h.repo.UpdateStatus(booking.ID, models.BookingStatusAttended); err != nil {
+			result.Status = booking.Status
+			result.Error = err.Error()
+		} else {
+			result.Status = updated.Status
+		}
+		results = append(results, result)
+	}
+
+	for id := range selected {
+		results = append(results, RosterCheckInResult{BookingID: id, Error: "booking is not on the roster"})
+	}
+
+	responses.ListResponse(w, results, len(results))
+}
+
+// checkIn marks a single booking as attended
+func (h *CheckInHandler) checkIn(w http.ResponseWriter, id string) {
+	booking, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Booking not found")
+		return
+	}
+
+	if err := booking.CanCheckIn(time.Now()); err != nil {
+		responses.ConflictResponse(w, err.Error(), nil)
+		return
+	}
+
+	booking, err = h.repo.UpdateStatus(id, models.BookingStatusAttended)
+	if err != nil {
+		if errors.Is(err, repositories.ErrBookingNotFound) {
+			responses.NotFoundResponse(w, "Booking not found")
+			return
+		}
+		writeBookingError(w, err)
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Member checked in", booking)
+}
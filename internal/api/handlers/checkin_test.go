@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/checkin"
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSigner() *checkin.TokenSigner {
+	return checkin.NewTokenSigner([]byte("test-secret"), 15*time.Minute)
+}
+
+func TestCheckInBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewCheckInHandler(mockRepo, newTestSigner())
+
+	mockBooking := &models.Booking{
+		ID:      "test-id",
+		Name:    "John Doe",
+		Date:    time.Now(),
+		ClassID: "test-class-id",
+		Status:  models.BookingStatusConfirmed,
+	}
+	attended := *mockBooking
+	attended.Status = models.BookingStatusAttended
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockBooking, nil)
+	mockRepo.EXPECT().UpdateStatus("test-id", models.BookingStatusAttended).Return(&attended, nil)
+
+	req := httptest.NewRequest("POST", "/bookings/test-id/check-in", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.CheckInBooking(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestCheckInBooking_BeforeClassDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewCheckInHandler(mockRepo, newTestSigner())
+
+	mockBooking := &models.Booking{
+		ID:      "test-id",
+		Name:    "John Doe",
+		Date:    time.Now().AddDate(0, 0, 3),
+		ClassID: "test-class-id",
+		Status:  models.BookingStatusConfirmed,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockBooking, nil)
+
+	req := httptest.NewRequest("POST", "/bookings/test-id/check-in", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.CheckInBooking(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestCheckInWithToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	signer := newTestSigner()
+	handler := NewCheckInHandler(mockRepo, signer)
+
+	mockBooking := &models.Booking{
+		ID:      "test-id",
+		Name:    "John Doe",
+		Date:    time.Now(),
+		ClassID: "test-class-id",
+		Status:  models.BookingStatusConfirmed,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockBooking, nil)
+	mockRepo.EXPECT().UpdateStatus("test-id", models.BookingStatusAttended).Return(mockBooking, nil)
+
+	token := signer.Issue("test-id", time.Now())
+	requestBody, _ := json.Marshal(models.CheckInInput{Token: token.Token})
+
+	req := httptest.NewRequest("POST", "/check-in", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CheckInWithToken(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestCheckInWithToken_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	signer := newTestSigner()
+	handler := NewCheckInHandler(mockRepo, signer)
+
+	expired := signer.Issue("test-id", time.Now().Add(-time.Hour)).Token
+	forged := checkin.NewTokenSigner([]byte("other-secret"), time.Hour).Issue("test-id", time.Now()).Token
+
+	for _, token := range []string{expired, forged, "not-a-token"} {
+		requestBody, _ := json.Marshal(models.CheckInInput{Token: token})
+
+		req := httptest.NewRequest("POST", "/check-in", bytes.NewBuffer(requestBody))
+		recorder := httptest.NewRecorder()
+
+		handler.CheckInWithToken(recorder, req)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	}
+}
+
+func TestCheckInRoster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewCheckInHandler(mockRepo, newTestSigner())
+
+	date := time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)
+	roster := []*models.Booking{
+		{ID: "booking-1", Name: "John", Date: date, ClassID: "1", Status: models.BookingStatusConfirmed},
+		{ID: "booking-2", Name: "Jane", Date: date, ClassID: "1", Status: models.BookingStatusAttended},
+	}
+	attended := *roster[0]
+	attended.Status = models.BookingStatusAttended
+
+	mockRepo.EXPECT().GetByClassAndDate("1", date).Return(roster)
+	mockRepo.EXPECT().UpdateStatus("booking-1", models.BookingStatusAttended).Return(&attended, nil)
+
+	requestBody, _ := json.Marshal(models.RosterCheckInInput{ClassID: "1", Date: "2022-01-05"})
+
+	req := httptest.NewRequest("POST", "/check-in/roster", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CheckInRoster(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data []RosterCheckInResult `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 2)
+	for _, result := range response.Data {
+		assert.Equal(t, models.BookingStatusAttended, result.Status)
+		assert.Empty(t, result.Error)
+	}
+}
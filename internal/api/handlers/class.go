@@ -4,7 +4,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"glofox-backend/internal/api/responses"
@@ -14,14 +18,55 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// Bounds of the number of results returned by class search
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
 // ClassHandler handles HTTP requests related to classes
 type ClassHandler struct {
-	repo repositories.ClassRepository
+	repo        repositories.ClassRepository
+	bookingRepo repositories.BookingRepository
+	closures    repositories.ClosureChecker
+	instructors repositories.InstructorRepository
+	rooms       repositories.RoomRepository
+}
+
+// InstructorConflictInfo points to the class an instructor already teaches at
+// the same time
+type InstructorConflictInfo struct {
+	InstructorID string `json:"instructorId"`
+	ClassID      string `json:"classId"`
+	Date         string `json:"date"`
+}
+
+// RoomConflictInfo points to the class already using a room at the same time
+type RoomConflictInfo struct {
+	RoomID  string `json:"roomId"`
+	ClassID string `json:"classId"`
+	Date    string `json:"date"`
+}
+
+// ClassUpdateResult holds an updated class, any booking conflicts that were
+// overridden with force and the bookings moved off overbooked dates
+type ClassUpdateResult struct {
+	Class       *models.Class              `json:"class"`
+	Conflicts   []models.BookingConflict   `json:"conflicts,omitempty"`
+	Overbooking []models.OverbookingReport `json:"overbooking,omitempty"`
+}
+
+// ClassRemovalResult holds the outcome of deleting or archiving a class and
+// the bookings that were cancelled with it
+type ClassRemovalResult struct {
+	Mode             models.ClassRemovalMode `json:"mode"`
+	Class            *models.Class           `json:"class,omitempty"`
+	AffectedBookings []*models.Booking       `json:"affectedBookings"`
 }
 
 // NewClassHandler creates a new ClassHandler instance
-func NewClassHandler(repo repositories.ClassRepository) *ClassHandler {
-	return &ClassHandler{repo: repo}
+func NewClassHandler(repo repositories.ClassRepository, bookingRepo repositories.BookingRepository, closures repositories.ClosureChecker, instructors repositories.InstructorRepository, rooms repositories.RoomRepository) *ClassHandler {
+	return &ClassHandler{repo: repo, bookingRepo: bookingRepo, closures: closures, instructors: instructors, rooms: rooms}
 }
 
 // CreateClass godoc
@@ -32,7 +77,8 @@ func NewClassHandler(repo repositories.ClassRepository) *ClassHandler {
 // @Produce json
 // @Param class body models.ClassInput true "Class information"
 // @Success 201 {object} responses.Response{data=models.Class} "Class created successfully"
-// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 400 {object} responses.Response "Invalid input or resolution, instructor or room not found, or capacity above the room capacity"
+// @Failure 409 {object} responses.Response{data=InstructorConflictInfo} "Instructor already teaches at the same time, or the room is in use (data=RoomConflictInfo)"
 // @Failure 500 {object} responses.Response "Server error"
 // @Router /classes [post]
 func (h *ClassHandler) CreateClass(w http.ResponseWriter, r *http.Request) {
@@ -48,8 +94,13 @@ func (h *ClassHandler) CreateClass(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.checkAssignments(class); err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
 	if err := h.repo.Create(class); err != nil {
-		responses.InternalServerErrorResponse(w)
+		writeClassError(w, err)
 		return
 	}
 
@@ -58,29 +109,84 @@ func (h *ClassHandler) CreateClass(w http.ResponseWriter, r *http.Request) {
 
 // GetAllClasses godoc
 // @Summary Get all classes
-// @Description Retrieves a list of all classes, optionally filtered by date
+// @Description Retrieves a list of all classes, optionally filtered by date, instructor, room, category, tags and difficulty. Filters combine.
 // @Tags classes
 // @Produce json
 // @Param date query string false "Filter classes by date (YYYY-MM-DD)"
+// @Param instructorId query string false "Filter classes taught by an instructor, including as a substitute"
+// @Param roomId query string false "Filter classes held in a room"
+// @Param category query string false "Filter classes by category, e.g. yoga"
+// @Param tags query string false "Comma separated tags a class must all have"
+// @Param difficulty query string false "Filter classes by difficulty (all-levels, beginner, intermediate, advanced)"
 // @Success 200 {object} responses.Response{data=[]models.Class} "List of classes"
-// @Failure 400 {object} responses.Response "Invalid date format"
+// @Failure 400 {object} responses.Response "Invalid date format or difficulty"
 // @Router /classes [get]
 func (h *ClassHandler) GetAllClasses(w http.ResponseWriter, r *http.Request) {
-	dateParam := r.URL.Query().Get("date")
-	if dateParam != "" {
-		date, err := time.Parse("2006-01-02", dateParam)
+	params := r.URL.Query()
+	query := repositories.ClassQuery{
+		InstructorID: params.Get("instructorId"),
+		RoomID:       params.Get("roomId"),
+		Category:     params.Get("category"),
+	}
+	if tags := params.Get("tags"); tags != "" {
+		query.Tags = strings.Split(tags, ",")
+	}
+
+	difficulty, err := models.ParseClassDifficulty(params.Get("difficulty"))
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+	query.Difficulty = difficulty
+
+	if dateParam := params.Get("date"); dateParam != "" {
+		date, err := models.ParseDate(dateParam)
 		if err != nil {
 			responses.BadRequestResponse(w, "Invalid date format. Use YYYY-MM-DD")
 			return
 		}
+		query.Date = date
+	}
 
-		classes := h.repo.GetByDate(date)
-		responses.ListResponse(w, classes, len(classes))
+	var classes []*models.Class
+	if query.IsZero() {
+		classes = h.repo.GetAll()
+	} else {
+		classes = h.repo.Find(query)
+	}
+	responses.ListResponse(w, classes, len(classes))
+}
+
+// SearchClasses godoc
+// @Summary Search classes
+// @Description Full-text search over class names, descriptions, categories, tags and instructor names. Matching is case-insensitive and every word of the query must match, a word also matching the start of longer ones. Results are ranked with name matches first and archived classes left out.
+// @Tags classes
+// @Produce json
+// @Param q query string true "Search words"
+// @Param limit query int false "Maximum number of results (default 20, max 100)"
+// @Success 200 {object} responses.Response{data=[]repositories.ClassSearchResult} "Matching classes, best first"
+// @Failure 400 {object} responses.Response "Missing query or invalid limit"
+// @Router /classes/search [get]
+func (h *ClassHandler) SearchClasses(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := strings.TrimSpace(params.Get("q"))
+	if query == "" {
+		responses.BadRequestResponse(w, "q is required")
 		return
 	}
 
-	classes := h.repo.GetAll()
-	responses.ListResponse(w, classes, len(classes))
+	limit := defaultSearchLimit
+	if value := params.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxSearchLimit {
+			responses.BadRequestResponse(w, fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	results := h.repo.Search(query, limit)
+	responses.ListResponse(w, results, len(results))
 }
 
 // GetClassByID godoc
@@ -104,3 +210,366 @@ func (h *ClassHandler) GetClassByID(w http.ResponseWriter, r *http.Request) {
 
 	responses.OKResponse(w, class)
 }
+
+// UpdateClass godoc
+// @Summary Replace a class
+// @Description Replaces all editable fields of a class. Changes that would strand upcoming bookings (dates removed from the range, capacity below the booked count) are rejected unless force is set. Forcing it cancels the waitlist of the removed dates. A capacity below the booked count can instead be resolved: resolution=waitlist or resolution=cancel keeps the earliest bookings of each date and moves the rest to the front of the waitlist or cancels them, reported per date.
+// @Tags classes
+// @Accept json
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param class body models.ClassInput true "Class information"
+// @Param force query bool false "Apply the update even if it strands bookings"
+// @Param resolution query string false "reject, waitlist or cancel bookings above a reduced capacity (default reject)"
+// @Param cancelledBy query string false "Who cancelled the bookings above the capacity"
+// @Param reason query string false "Reason given to the bookings cancelled above the capacity"
+// @Success 200 {object} responses.Response{data=ClassUpdateResult} "Class updated"
+// @Failure 400 {object} responses.Response "Invalid input or resolution, instructor or room not found, or capacity above the room capacity"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Failure 409 {object} responses.Response{data=[]models.BookingConflict} "Update would strand bookings, the instructor already teaches at the same time (data=InstructorConflictInfo) or the room is in use (data=RoomConflictInfo)"
+// @Router /classes/{id} [put]
+func (h *ClassHandler) UpdateClass(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	var input models.ClassInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	h.applyUpdate(w, r, id, func(*models.Class) models.ClassInput {
+		return input
+	})
+}
+
+// PatchClass godoc
+// @Summary Update a class
+// @Description Updates the given fields of a class. Changes that would strand upcoming bookings (dates removed from the range, capacity below the booked count) are rejected unless force is set. Forcing it cancels the waitlist of the removed dates. A capacity below the booked count can instead be resolved: resolution=waitlist or resolution=cancel keeps the earliest bookings of each date and moves the rest to the front of the waitlist or cancels them, reported per date.
+// @Tags classes
+// @Accept json
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param class body models.ClassPatchInput true "Fields to update"
+// @Param force query bool false "Apply the update even if it strands bookings"
+// @Param resolution query string false "reject, waitlist or cancel bookings above a reduced capacity (default reject)"
+// @Param cancelledBy query string false "Who cancelled the bookings above the capacity"
+// @Param reason query string false "Reason given to the bookings cancelled above the capacity"
+// @Success 200 {object} responses.Response{data=ClassUpdateResult} "Class updated"
+// @Failure 400 {object} responses.Response "Invalid input or resolution, instructor or room not found, or capacity above the room capacity"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Failure 409 {object} responses.Response{data=[]models.BookingConflict} "Update would strand bookings, the instructor already teaches at the same time (data=InstructorConflictInfo) or the room is in use (data=RoomConflictInfo)"
+// @Router /classes/{id} [patch]
+func (h *ClassHandler) PatchClass(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	var patch models.ClassPatchInput
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	h.applyUpdate(w, r, id, func(class *models.Class) models.ClassInput {
+		return patch.Apply(class.ToInput())
+	})
+}
+
+// applyUpdate validates and stores a class update, checking it against the
+// class bookings. The instructors and room are checked before the bookings
+// are locked. The input is then built again from the class as stored when
+// the update is applied, so changes made to it meanwhile are kept.
+func (h *ClassHandler) applyUpdate(w http.ResponseWriter, r *http.Request, id string, input func(*models.Class) models.ClassInput) {
+	options, err := classChangeOptions(r, defaultCapacityReductionReason)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	class, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+	updated, err := class.Updated(input(class))
+	if err == nil {
+		err = h.checkAssignments(updated)
+	}
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	var invalid error
+	updated, conflicts, overbooking, err := h.bookingRepo.ApplyClassUpdate(id, func(class *models.Class) (*models.Class, error) {
+		updated, err := class.Updated(input(class))
+		invalid = err
+		return updated, err
+	}, options)
+	if invalid != nil {
+		responses.BadRequestResponse(w, invalid.Error())
+		return
+	}
+	if err != nil {
+		writeClassError(w, err)
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Class updated successfully", ClassUpdateResult{
+		Class:       updated,
+		Conflicts:   conflicts,
+		Overbooking: overbooking,
+	})
+}
+
+// classChangeOptions reads from the query how a class change treats the
+// bookings it strands. Bookings it cancels get the reason from the query,
+// or defaultReason.
+func classChangeOptions(r *http.Request, defaultReason string) (models.ClassChangeOptions, error) {
+	query := r.URL.Query()
+	resolution, err := models.ParseCapacityResolution(query.Get("resolution"))
+	if err != nil {
+		return models.ClassChangeOptions{}, err
+	}
+
+	cancellation := models.Cancellation{
+		CancelledBy: query.Get("cancelledBy"),
+		Reason:      query.Get("reason"),
+		CancelledAt: time.Now(),
+	}
+	if cancellation.Reason == "" {
+		cancellation.Reason = defaultReason
+	}
+
+	return models.ClassChangeOptions{
+		Force:        query.Get("force") == "true",
+		Resolution:   resolution,
+		Cancellation: cancellation,
+	}, nil
+}
+
+// DeleteClass godoc
+// @Summary Delete a class
+// @Description Removes a class. mode=reject (default) refuses when the class has bookings, mode=cascade cancels upcoming bookings and deletes the class, mode=archive cancels upcoming bookings and keeps the class and its history but stops taking bookings. The cancelled bookings are returned.
+// @Tags classes
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param mode query string false "reject, cascade or archive (default reject)"
+// @Param cancelledBy query string false "Who cancelled the class"
+// @Param reason query string false "Reason given to the cancelled bookings"
+// @Success 200 {object} responses.Response{data=ClassRemovalResult} "Class removed"
+// @Failure 400 {object} responses.Response "Invalid mode"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Failure 409 {object} responses.Response{data=[]models.Booking} "Class has bookings"
+// @Router /classes/{id} [delete]
+func (h *ClassHandler) DeleteClass(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	query := r.URL.Query()
+	mode, err := models.ParseClassRemovalMode(query.Get("mode"))
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	cancellation := models.Cancellation{
+		CancelledBy: query.Get("cancelledBy"),
+		Reason:      query.Get("reason"),
+		CancelledAt: time.Now(),
+	}
+	if cancellation.Reason == "" {
+		cancellation.Reason = defaultClassCancellationReason
+	}
+
+	affected, err := h.bookingRepo.RemoveClass(id, mode, cancellation)
+	if err != nil {
+		var bookingsErr *repositories.ClassHasBookingsError
+		switch {
+		case errors.As(err, &bookingsErr):
+			responses.ConflictResponse(w, bookingsErr.Error()+", use mode=cascade or mode=archive to cancel them", bookingsErr.Bookings)
+		case errors.Is(err, repositories.ErrClassNotFound):
+			responses.NotFoundResponse(w, "Class not found")
+		default:
+			responses.InternalServerErrorResponse(w)
+		}
+		return
+	}
+
+	result := ClassRemovalResult{Mode: mode, AffectedBookings: affected}
+	if mode == models.ClassRemovalArchive {
+		if class, err := h.repo.GetByID(id); err == nil {
+			result.Class = class
+		}
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Class removed successfully", result)
+}
+
+// GetClassAvailability godoc
+// @Summary Get class availability
+// @Description Retrieves capacity, booked spots, waitlist size and remaining spots for each date the class runs in a date range, leaving out studio closures
+// @Tags classes
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param from query string false "First date (YYYY-MM-DD), defaults to the class start date, or today for classes running more than 366 days"
+// @Param to query string false "Last date (YYYY-MM-DD), defaults to the class end date, at most 366 days after the first date"
+// @Success 200 {object} responses.Response{data=[]models.Availability} "Availability per date"
+// @Failure 400 {object} responses.Response "Invalid date range"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Router /classes/{id}/availability [get]
+func (h *ClassHandler) GetClassAvailability(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	class, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+
+	occurrences, err := h.occurrences(r, class)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	availability := make([]models.Availability, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		booked, waitlisted := h.bookingRepo.CountByClassAndDate(class.ID, occurrence.Date)
+		availability = append(availability, models.NewAvailability(occurrence, booked, waitlisted))
+	}
+
+	responses.ListResponse(w, availability, len(availability))
+}
+
+// GetClassOccurrences godoc
+// @Summary Get class occurrences
+// @Description Expands the class schedule into the occurrences it actually runs on in a date range, with overrides applied and cancelled occurrences and studio closures left out. Occurrence IDs are stable and can be booked with occurrenceId.
+// @Tags classes
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param from query string false "First date (YYYY-MM-DD), defaults to the class start date, or today for classes running more than 366 days"
+// @Param to query string false "Last date (YYYY-MM-DD), defaults to the class end date, at most 366 days after the first date"
+// @Success 200 {object} responses.Response{data=[]models.Occurrence} "Occurrences in date order"
+// @Failure 400 {object} responses.Response "Invalid date range"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Router /classes/{id}/occurrences [get]
+func (h *ClassHandler) GetClassOccurrences(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	class, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+
+	occurrences, err := h.occurrences(r, class)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	responses.ListResponse(w, occurrences, len(occurrences))
+}
+
+// occurrences expands the class schedule in the from/to range of the request,
+// leaving out studio closures
+func (h *ClassHandler) occurrences(r *http.Request, class *models.Class) ([]models.Occurrence, error) {
+	from, to, err := parseDateRangeQuery(r, "from", "to")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := class.Dates(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]models.Occurrence, 0, len(dates))
+	for _, date := range dates {
+		if h.closures.ClosureOn(date) != nil {
+			continue
+		}
+		occurrences = append(occurrences, class.OccurrenceOn(date))
+	}
+	return occurrences, nil
+}
+
+// checkAssignments verifies that the instructors and room assigned to a class
+// exist and that the class fits in its room
+func (h *ClassHandler) checkAssignments(class *models.Class) error {
+	for _, id := range class.InstructorIDs() {
+		if _, err := h.instructors.GetByID(id); err != nil {
+			return err
+		}
+	}
+
+	if class.RoomID == "" {
+		return nil
+	}
+	room, err := h.rooms.GetByID(class.RoomID)
+	if err != nil {
+		return err
+	}
+	return room.Fits(class)
+}
+
+// writeClassError maps repository errors from storing a class to API
+// responses
+func writeClassError(w http.ResponseWriter, err error) {
+	var conflictErr *repositories.ClassUpdateConflictError
+	var instructorErr *repositories.InstructorConflictError
+	var roomErr *repositories.RoomConflictError
+
+	switch {
+	case errors.As(err, &conflictErr):
+		responses.ConflictResponse(w, conflictErr.Error()+", use force=true to apply it anyway or resolution=waitlist or resolution=cancel to move the bookings above the capacity", conflictErr.Conflicts)
+	case errors.As(err, &instructorErr):
+		responses.ConflictResponse(w, instructorErr.Error(), InstructorConflictInfo{
+			InstructorID: instructorErr.InstructorID,
+			ClassID:      instructorErr.ClassID,
+			Date:         instructorErr.Date.Format("2006-01-02"),
+		})
+	case errors.As(err, &roomErr):
+		responses.ConflictResponse(w, roomErr.Error(), RoomConflictInfo{
+			RoomID:  roomErr.RoomID,
+			ClassID: roomErr.ClassID,
+			Date:    roomErr.Date.Format("2006-01-02"),
+		})
+	case errors.Is(err, repositories.ErrClassNotFound):
+		responses.NotFoundResponse(w, "Class not found")
+	case errors.Is(err, repositories.ErrDateOutOfRange):
+		responses.BadRequestResponse(w, err.Error())
+	default:
+		responses.InternalServerErrorResponse(w)
+	}
+}
+
+// parseDateRangeQuery reads two optional YYYY-MM-DD query parameters bounding
+// a date range. Missing bounds are returned as zero times.
+func parseDateRangeQuery(r *http.Request, fromParam, toParam string) (time.Time, time.Time, error) {
+	var from, to time.Time
+	var err error
+
+	if value := r.URL.Query().Get(fromParam); value != "" {
+		from, err = models.ParseDate(value)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid %s date format. Use YYYY-MM-DD", fromParam)
+		}
+	}
+
+	if value := r.URL.Query().Get(toParam); value != "" {
+		to, err = models.ParseDate(value)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid %s date format. Use YYYY-MM-DD", toParam)
+		}
+	}
+
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, fmt.Errorf("%s must not be before %s", toParam, fromParam)
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,192 @@
+// File: internal/api/handlers/class_override.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// defaultClassCancellationReason is recorded on bookings cancelled with a
+// class or occurrence when no reason is given
+const defaultClassCancellationReason = "class cancelled"
+
+// defaultCapacityReductionReason is recorded on bookings cancelled because
+// the class capacity was reduced below them when no reason is given
+const defaultCapacityReductionReason = "class capacity reduced"
+
+// ClassOverrideResult holds a class after one of its occurrences changed, the
+// bookings cancelled with the occurrence, any booking conflicts that were
+// overridden with force and the bookings moved off the occurrence when it
+// was overbooked
+type ClassOverrideResult struct {
+	Class             *models.Class              `json:"class"`
+	Override          *models.ClassOverride      `json:"override,omitempty"`
+	CancelledBookings []*models.Booking          `json:"cancelledBookings"`
+	Conflicts         []models.BookingConflict   `json:"conflicts,omitempty"`
+	Overbooking       []models.OverbookingReport `json:"overbooking,omitempty"`
+}
+
+// GetClassOverrides godoc
+// @Summary Get class occurrence overrides
+// @Description Retrieves the changes made to single occurrences of a class, in date order
+// @Tags classes
+// @Produce json
+// @Param id path string true "Class ID"
+// @Success 200 {object} responses.Response{data=[]models.ClassOverride} "Overrides"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Router /classes/{id}/overrides [get]
+func (h *ClassHandler) GetClassOverrides(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	class, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+
+	overrides := make([]models.ClassOverride, 0, len(class.Overrides))
+	for _, override := range class.Overrides {
+		overrides = append(overrides, override)
+	}
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].Date.Before(overrides[j].Date)
+	})
+
+	responses.ListResponse(w, overrides, len(overrides))
+}
+
+// SetClassOverride godoc
+// @Summary Override a class occurrence
+// @Description Changes a single occurrence of a class: cancels it (cancelling its bookings), changes its capacity, moves its session time or sets a substitute instructor. Replaces any earlier override of the same date. A capacity below the booked count is rejected unless force is set, or resolved with resolution=waitlist or resolution=cancel, which keeps the earliest bookings and moves the rest to the front of the waitlist or cancels them.
+// @Tags classes
+// @Accept json
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param date path string true "Occurrence date (YYYY-MM-DD)"
+// @Param override body models.ClassOverrideInput true "Occurrence changes"
+// @Param force query bool false "Apply the override even if the occurrence is overbooked"
+// @Param resolution query string false "reject, waitlist or cancel bookings above a reduced capacity (default reject)"
+// @Param cancelledBy query string false "Who cancelled the occurrence"
+// @Param reason query string false "Reason given to the bookings cancelled above the capacity"
+// @Success 200 {object} responses.Response{data=ClassOverrideResult} "Occurrence updated"
+// @Failure 400 {object} responses.Response "Invalid input or resolution, instructor not found, capacity above the room capacity or the class does not run on the date"
+// @Failure 404 {object} responses.Response "Class not found"
+// @Failure 409 {object} responses.Response{data=[]models.BookingConflict} "Occurrence is overbooked, or the substitute already teaches at the same time (data=InstructorConflictInfo)"
+// @Router /classes/{id}/overrides/{date} [put]
+func (h *ClassHandler) SetClassOverride(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	date, err := models.ParseDate(vars["date"])
+	if err != nil {
+		responses.BadRequestResponse(w, "invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	var input models.ClassOverrideInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	override, err := models.NewClassOverride(date, input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	class, err := h.repo.GetByID(vars["id"])
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+
+	if err := h.checkAssignments(class.WithOverride(date, override)); err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	h.applyOverride(w, r, vars["id"], date, override)
+}
+
+// DeleteClassOverride godoc
+// @Summary Remove a class occurrence override
+// @Description Restores a single occurrence of a class to its regular schedule. Bookings cancelled with the occurrence stay cancelled. Restoring a capacity below the booked count is rejected unless force is set or resolved with resolution=waitlist or resolution=cancel.
+// @Tags classes
+// @Produce json
+// @Param id path string true "Class ID"
+// @Param date path string true "Occurrence date (YYYY-MM-DD)"
+// @Param force query bool false "Restore the occurrence even if it is overbooked"
+// @Param resolution query string false "reject, waitlist or cancel bookings above the restored capacity (default reject)"
+// @Param cancelledBy query string false "Who cancelled the bookings above the capacity"
+// @Param reason query string false "Reason given to the bookings cancelled above the capacity"
+// @Success 200 {object} responses.Response{data=ClassOverrideResult} "Occurrence restored"
+// @Failure 400 {object} responses.Response "Invalid date or resolution, or the class does not run on the date"
+// @Failure 404 {object} responses.Response "Class or override not found"
+// @Failure 409 {object} responses.Response{data=[]models.BookingConflict} "Occurrence is overbooked"
+// @Router /classes/{id}/overrides/{date} [delete]
+func (h *ClassHandler) DeleteClassOverride(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	date, err := models.ParseDate(vars["date"])
+	if err != nil {
+		responses.BadRequestResponse(w, "invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	class, err := h.repo.GetByID(vars["id"])
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+	if _, exists := class.OverrideOn(date); !exists {
+		responses.NotFoundResponse(w, "Override not found")
+		return
+	}
+
+	h.applyOverride(w, r, class.ID, date, nil)
+}
+
+// applyOverride stores or removes the override of a class occurrence and
+// reports what happened to its bookings
+func (h *ClassHandler) applyOverride(w http.ResponseWriter, r *http.Request, classID string, date time.Time, override *models.ClassOverride) {
+	defaultReason := defaultCapacityReductionReason
+	if override != nil && override.Cancelled {
+		defaultReason = defaultClassCancellationReason
+	}
+
+	options, err := classChangeOptions(r, defaultReason)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+	if override != nil && override.Reason != "" {
+		options.Cancellation.Reason = override.Reason
+	}
+
+	cancelled, conflicts, overbooking, err := h.bookingRepo.SetClassOverride(classID, date, override, options)
+	if err != nil {
+		writeClassError(w, err)
+		return
+	}
+
+	class, err := h.repo.GetByID(classID)
+	if err != nil {
+		responses.NotFoundResponse(w, "Class not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Class occurrence updated successfully", ClassOverrideResult{
+		Class:             class,
+		Override:          override,
+		CancelledBookings: cancelled,
+		Conflicts:         conflicts,
+		Overbooking:       overbooking,
+	})
+}
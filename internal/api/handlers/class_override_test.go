@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetClassOverride_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	date := time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC)
+	cancelled := []*models.Booking{{ID: "booking-1", ClassID: "test-id", Date: date, Status: models.BookingStatusCancelled}}
+
+	mockBookingRepo.EXPECT().SetClassOverride("test-id", date, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, _ time.Time, override *models.ClassOverride, options models.ClassChangeOptions) ([]*models.Booking, []models.BookingConflict, []models.OverbookingReport, error) {
+			assert.True(t, override.Cancelled)
+			assert.Equal(t, "Instructor unavailable", options.Cancellation.Reason)
+			return cancelled, []models.BookingConflict{}, []models.OverbookingReport{}, nil
+		})
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id"}, nil).Times(2)
+
+	requestBody, _ := json.Marshal(models.ClassOverrideInput{Cancelled: true, Reason: "Instructor unavailable"})
+	req := httptest.NewRequest("PUT", "/classes/test-id/overrides/2022-01-04", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id", "date": "2022-01-04"})
+	recorder := httptest.NewRecorder()
+
+	handler.SetClassOverride(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-1")
+}
+
+func TestSetClassOverride_Overbooked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	date := time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id", Capacity: 10}, nil)
+	conflicts := []models.BookingConflict{{Date: date, Reason: models.ConflictOverCapacity, Booked: 5, Capacity: 3}}
+	mockBookingRepo.EXPECT().SetClassOverride("test-id", date, gomock.Any(), gomock.Any()).
+		Return(nil, conflicts, nil, &repositories.ClassUpdateConflictError{Conflicts: conflicts})
+
+	requestBody, _ := json.Marshal(models.ClassOverrideInput{Capacity: 3})
+	req := httptest.NewRequest("PUT", "/classes/test-id/overrides/2022-01-04", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id", "date": "2022-01-04"})
+	recorder := httptest.NewRecorder()
+
+	handler.SetClassOverride(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestSetClassOverride_InvalidTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClassOverrideInput{StartTime: "25:00"})
+	req := httptest.NewRequest("PUT", "/classes/test-id/overrides/2022-01-04", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id", "date": "2022-01-04"})
+	recorder := httptest.NewRecorder()
+
+	handler.SetClassOverride(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestDeleteClassOverride_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id"}, nil)
+
+	req := httptest.NewRequest("DELETE", "/classes/test-id/overrides/2022-01-04", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id", "date": "2022-01-04"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteClassOverride(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGetClassAvailability_Override(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	mockClosures := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mockClosures, mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+		Overrides: map[string]models.ClassOverride{
+			"2022-01-02": {Date: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), Cancelled: true},
+			"2022-01-03": {Date: time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), Capacity: 20},
+		},
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockClosures.EXPECT().ClosureOn(gomock.Any()).Return(nil).AnyTimes()
+	mockBookingRepo.EXPECT().CountByClassAndDate("test-id", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)).Return(10, 0)
+	mockBookingRepo.EXPECT().CountByClassAndDate("test-id", time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)).Return(10, 0)
+
+	req := httptest.NewRequest("GET", "/classes/test-id/availability", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassAvailability(recorder, req)
+
+	var response struct {
+		Data []models.Availability `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, 0, response.Data[0].Remaining)
+	assert.Equal(t, 10, response.Data[1].Remaining)
+}
+
+func TestSetClassOverride_AboveRoomCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockRooms := mocks.NewMockRoomRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mockRooms)
+
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id", RoomID: "room-1", Capacity: 10}, nil)
+	mockRooms.EXPECT().GetByID("room-1").Return(&models.Room{ID: "room-1", Name: "Studio A", Capacity: 12}, nil)
+
+	requestBody, _ := json.Marshal(models.ClassOverrideInput{Capacity: 15})
+	req := httptest.NewRequest("PUT", "/classes/test-id/overrides/2022-01-04", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id", "date": "2022-01-04"})
+	recorder := httptest.NewRecorder()
+
+	handler.SetClassOverride(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Studio A")
+}
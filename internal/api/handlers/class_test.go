@@ -10,6 +10,7 @@ import (
 
 	"glofox-backend/internal/mocks"
 	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -21,7 +22,7 @@ func TestCreateClass(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockClassRepository(ctrl)
-	handler := NewClassHandler(mockRepo)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
 
 	classInput := models.ClassInput{
 		ClassName: "Test Class",
@@ -47,7 +48,7 @@ func TestGetClassByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockClassRepository(ctrl)
-	handler := NewClassHandler(mockRepo)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
 
 	mockClass := &models.Class{
 		ID:        "test-id",
@@ -74,7 +75,7 @@ func TestGetAllClasses(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockClassRepository(ctrl)
-	handler := NewClassHandler(mockRepo)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
 
 	mockClasses := []*models.Class{
 		{ID: "test-id-1", ClassName: "Class 1", StartDate: time.Now(), EndDate: time.Now(), Capacity: 10, CreatedAt: time.Now()},
@@ -91,6 +92,597 @@ func TestGetAllClasses(t *testing.T) {
 	assert.Equal(t, http.StatusOK, recorder.Code)
 }
 
+func TestGetClassAvailability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	mockClosures := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mockClosures, mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		ClassName: "Test Class",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockClosures.EXPECT().ClosureOn(gomock.Any()).Return(nil).AnyTimes()
+	mockBookingRepo.EXPECT().CountByClassAndDate("test-id", time.Date(2022, 1, 9, 0, 0, 0, 0, time.UTC)).Return(4, 0)
+	mockBookingRepo.EXPECT().CountByClassAndDate("test-id", time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)).Return(10, 2)
+
+	req := httptest.NewRequest("GET", "/classes/test-id/availability?from=2022-01-09&to=2022-01-20", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassAvailability(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data []models.Availability `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, 6, response.Data[0].Remaining)
+	assert.Equal(t, 0, response.Data[1].Remaining)
+	assert.Equal(t, 2, response.Data[1].Waitlisted)
+}
+
+func TestGetClassOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockClosures := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mockClosures, mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	closed := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	mockClass := &models.Class{
+		ID:        "test-id",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+		Overrides: map[string]models.ClassOverride{
+			"2022-01-01": {Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Cancelled: true},
+			"2022-01-02": {Date: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), Capacity: 5},
+		},
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockClosures.EXPECT().ClosureOn(closed).Return(&models.Closure{StartDate: closed, EndDate: closed})
+	mockClosures.EXPECT().ClosureOn(gomock.Any()).Return(nil).AnyTimes()
+
+	req := httptest.NewRequest("GET", "/classes/test-id/occurrences", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassOccurrences(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data []models.Occurrence `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 2)
+	assert.Equal(t, "test-id:2022-01-02", response.Data[0].ID)
+	assert.Equal(t, 5, response.Data[0].Capacity)
+	assert.True(t, response.Data[0].Overridden)
+	assert.Equal(t, "test-id:2022-01-04", response.Data[1].ID)
+	assert.Equal(t, 10, response.Data[1].Capacity)
+}
+
+func TestGetClassAvailability_Recurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	mockClosures := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mockClosures, mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	// Mondays and Wednesdays, skipping Wednesday 5 January
+	mockClass := &models.Class{
+		ID:         "test-id",
+		ClassName:  "Test Class",
+		StartDate:  time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2022, 1, 16, 0, 0, 0, 0, time.UTC),
+		Recurrence: "FREQ=WEEKLY;BYDAY=MO,WE",
+		ExDates:    []time.Time{time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)},
+		Capacity:   10,
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockClosures.EXPECT().ClosureOn(gomock.Any()).Return(nil).AnyTimes()
+	for _, day := range []int{3, 10, 12} {
+		mockBookingRepo.EXPECT().CountByClassAndDate("test-id", time.Date(2022, 1, day, 0, 0, 0, 0, time.UTC)).Return(0, 0)
+	}
+
+	req := httptest.NewRequest("GET", "/classes/test-id/availability", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassAvailability(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data []models.Availability `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 3)
+}
+
+func TestGetClassAvailability_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id"}, nil)
+
+	req := httptest.NewRequest("GET", "/classes/test-id/availability?from=2022-01-09&to=2022-01-01", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassAvailability(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestPatchClass(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		ClassName: "Test Class",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+		Overrides: map[string]models.ClassOverride{
+			"2022-01-05": {Date: time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC), Cancelled: true},
+		},
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockBookingRepo.EXPECT().ApplyClassUpdate("test-id", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error) {
+			class, err := update(mockClass)
+			assert.NoError(t, err)
+			assert.Equal(t, "Test Class", class.ClassName)
+			assert.Equal(t, 20, class.Capacity)
+			assert.True(t, class.IsCancelledOn(time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC)))
+			assert.False(t, options.Force)
+			return class, nil, nil, nil
+		})
+
+	requestBody := []byte(`{"capacity": 20}`)
+	req := httptest.NewRequest("PATCH", "/classes/test-id", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.PatchClass(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestUpdateClass_StrandsBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		ClassName: "Test Class",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+	}
+	conflicts := []models.BookingConflict{{
+		Date:       time.Date(2022, 1, 9, 0, 0, 0, 0, time.UTC),
+		Reason:     models.ConflictOutOfRange,
+		Booked:     3,
+		Capacity:   10,
+		BookingIDs: []string{"a", "b", "c"},
+	}}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockBookingRepo.EXPECT().ApplyClassUpdate("test-id", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error) {
+			_, err := update(mockClass)
+			assert.NoError(t, err)
+			return nil, conflicts, nil, &repositories.ClassUpdateConflictError{Conflicts: conflicts}
+		})
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Test Class",
+		StartDate: "2022-01-01",
+		EndDate:   "2022-01-05",
+		Capacity:  10,
+	})
+	req := httptest.NewRequest("PUT", "/classes/test-id", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateClass(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestPatchClass_ResolveOverbooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		ClassName: "Test Class",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+	}
+	report := models.OverbookingReport{
+		Date:           time.Date(2022, 1, 4, 0, 0, 0, 0, time.UTC),
+		Resolution:     models.CapacityResolutionCancel,
+		Capacity:       2,
+		KeptBookingIDs: []string{"booking-1", "booking-2"},
+		Affected:       []*models.Booking{{ID: "booking-3", Status: models.BookingStatusCancelled}},
+	}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockBookingRepo.EXPECT().ApplyClassUpdate("test-id", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error) {
+			class, err := update(mockClass)
+			assert.NoError(t, err)
+			assert.Equal(t, models.CapacityResolutionCancel, options.Resolution)
+			assert.Equal(t, "Room maintenance", options.Cancellation.Reason)
+			return class, []models.BookingConflict{}, []models.OverbookingReport{report}, nil
+		})
+
+	requestBody := []byte(`{"capacity": 2}`)
+	req := httptest.NewRequest("PATCH", "/classes/test-id?resolution=cancel&reason=Room+maintenance", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.PatchClass(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-3")
+}
+
+func TestPatchClass_InvalidResolution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody := []byte(`{"capacity": 2}`)
+	req := httptest.NewRequest("PATCH", "/classes/test-id?resolution=drop", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.PatchClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateClass_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	// The input is rejected before the bookings are checked
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{ID: "test-id"}, nil)
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Test Class",
+		StartDate: "2022-01-10",
+		EndDate:   "2022-01-01",
+		Capacity:  10,
+	})
+	req := httptest.NewRequest("PUT", "/classes/test-id", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestPatchClass_RoomNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockRooms := mocks.NewMockRoomRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mockRooms)
+
+	mockRepo.EXPECT().GetByID("test-id").Return(&models.Class{
+		ID:        "test-id",
+		ClassName: "Test Class",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+	}, nil)
+	mockRooms.EXPECT().GetByID("missing-room").Return(nil, repositories.ErrRoomNotFound)
+
+	requestBody := []byte(`{"roomId": "missing-room"}`)
+	req := httptest.NewRequest("PATCH", "/classes/test-id", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.PatchClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
 //remember for generating mocks
 
 // mockgen -source=internal/repositories/booking.go -destination=internal/mocks/mock_booking_repository.go -package=mocks
+
+func TestDeleteClass_Cascade(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	affected := []*models.Booking{{ID: "booking-1", ClassID: "test-id", Status: models.BookingStatusCancelled}}
+	mockBookingRepo.EXPECT().RemoveClass("test-id", models.ClassRemovalCascade, gomock.Any()).
+		DoAndReturn(func(_ string, _ models.ClassRemovalMode, cancellation models.Cancellation) ([]*models.Booking, error) {
+			assert.Equal(t, "instructor ill", cancellation.Reason)
+			return affected, nil
+		})
+
+	req := httptest.NewRequest("DELETE", "/classes/test-id?mode=cascade&reason=instructor+ill", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteClass(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-1")
+}
+
+func TestDeleteClass_HasBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	bookings := []*models.Booking{{ID: "booking-1", ClassID: "test-id"}}
+	mockBookingRepo.EXPECT().RemoveClass("test-id", models.ClassRemovalReject, gomock.Any()).
+		Return(nil, &repositories.ClassHasBookingsError{Bookings: bookings})
+
+	req := httptest.NewRequest("DELETE", "/classes/test-id", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteClass(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestDeleteClass_InvalidMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	req := httptest.NewRequest("DELETE", "/classes/test-id?mode=purge", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateClass_SessionTimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(class *models.Class) error {
+		assert.Equal(t, "07:00", class.StartTime)
+		assert.Equal(t, 60, class.DurationMinutes)
+		assert.Equal(t, "08:00", class.EndTime)
+		assert.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), class.StartDate)
+		return nil
+	})
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Morning Yoga",
+		StartDate: "2022-01-01T07:00:00Z",
+		EndDate:   "2022-01-10T08:00:00Z",
+		Capacity:  10,
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestCreateClass_InvalidSessionTimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Evening Yoga",
+		StartDate: "2022-01-01",
+		EndDate:   "2022-01-10",
+		StartTime: "18:00",
+		EndTime:   "17:00",
+		Capacity:  10,
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateClass_InvalidRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName:  "Weekend Yoga",
+		StartDate:  "2022-01-01",
+		EndDate:    "2022-03-01",
+		Recurrence: "FREQ=WEEKLY;BYDAY=XX",
+		Capacity:   10,
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateClass_MinAttendanceAboveCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName:     "Yoga",
+		StartDate:     "2022-01-01",
+		EndDate:       "2022-01-10",
+		Capacity:      10,
+		MinAttendance: 12,
+		CutoffMinutes: 120,
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "minAttendance")
+}
+
+func TestCreateClass_MinAttendanceWithoutCutoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName:     "Yoga",
+		StartDate:     "2022-01-01",
+		EndDate:       "2022-01-10",
+		Capacity:      10,
+		MinAttendance: 3,
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "cutoffMinutes")
+}
+
+func TestGetAllClasses_Filters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockRepo.EXPECT().Find(repositories.ClassQuery{
+		Date:       time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+		Category:   "Yoga",
+		Tags:       []string{"morning", "outdoor"},
+		Difficulty: models.ClassDifficultyBeginner,
+	}).Return([]*models.Class{{ID: "class-1", Category: "yoga"}})
+
+	req := httptest.NewRequest("GET", "/classes?date=2022-01-03&category=Yoga&tags=morning,outdoor&difficulty=Beginner", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllClasses(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "class-1")
+}
+
+func TestGetAllClasses_InvalidDifficulty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	req := httptest.NewRequest("GET", "/classes?difficulty=expert", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.GetAllClasses(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestSearchClasses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockRepo.EXPECT().Search("morning yoga", 5).Return([]repositories.ClassSearchResult{
+		{Class: &models.Class{ID: "class-1", ClassName: "Morning Yoga"}, Score: 8},
+	})
+
+	req := httptest.NewRequest("GET", "/classes/search?q=morning+yoga&limit=5", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.SearchClasses(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "class-1")
+}
+
+func TestSearchClasses_MissingQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	req := httptest.NewRequest("GET", "/classes/search?q=+", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.SearchClasses(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,141 @@
+// File: internal/api/handlers/closure.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// ClosureHandler handles HTTP requests related to studio closures
+type ClosureHandler struct {
+	repo        repositories.ClosureRepository
+	bookingRepo repositories.BookingRepository
+}
+
+// ClosureResult holds a new closure with the bookings that fall on it, either
+// cancelled with cancelBookings or left for staff to follow up
+type ClosureResult struct {
+	Closure           *models.Closure   `json:"closure"`
+	CancelledBookings []*models.Booking `json:"cancelledBookings"`
+	AffectedBookings  []*models.Booking `json:"affectedBookings"`
+}
+
+// NewClosureHandler creates a new ClosureHandler instance
+func NewClosureHandler(repo repositories.ClosureRepository, bookingRepo repositories.BookingRepository) *ClosureHandler {
+	return &ClosureHandler{repo: repo, bookingRepo: bookingRepo}
+}
+
+// CreateClosure godoc
+// @Summary Create a studio closure
+// @Description Closes the studio on a day or range of days, e.g. a public holiday or maintenance. No class can be booked while the studio is closed. Bookings that already fall on the closure are cancelled when cancelBookings is set, otherwise they are listed in affectedBookings.
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Param closure body models.ClosureInput true "Closure information"
+// @Param cancelledBy query string false "Who closed the studio"
+// @Success 201 {object} responses.Response{data=ClosureResult} "Closure created"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Router /closures [post]
+func (h *ClosureHandler) CreateClosure(w http.ResponseWriter, r *http.Request) {
+	var input models.ClosureInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	closure, err := models.NewClosure(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Create(closure); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	result := ClosureResult{
+		Closure:           closure,
+		CancelledBookings: make([]*models.Booking, 0),
+		AffectedBookings:  make([]*models.Booking, 0),
+	}
+
+	if input.CancelBookings {
+		result.CancelledBookings = h.bookingRepo.CancelByDateRange(closure.StartDate, closure.EndDate, models.Cancellation{
+			CancelledBy: r.URL.Query().Get("cancelledBy"),
+			Reason:      "studio closed: " + closure.Reason,
+			CancelledAt: time.Now(),
+		})
+	} else {
+		result.AffectedBookings = h.bookingRepo.Find(repositories.BookingQuery{
+			Statuses: []models.BookingStatus{models.BookingStatusConfirmed, models.BookingStatusWaitlisted},
+			DateFrom: closure.StartDate,
+			DateTo:   closure.EndDate,
+		})
+	}
+
+	responses.CreatedResponse(w, "Closure created successfully", result)
+}
+
+// GetAllClosures godoc
+// @Summary Get all studio closures
+// @Description Retrieves all studio closures ordered by start date
+// @Tags closures
+// @Produce json
+// @Success 200 {object} responses.Response{data=[]models.Closure} "List of closures"
+// @Router /closures [get]
+func (h *ClosureHandler) GetAllClosures(w http.ResponseWriter, r *http.Request) {
+	closures := h.repo.GetAll()
+	responses.ListResponse(w, closures, len(closures))
+}
+
+// GetClosureByID godoc
+// @Summary Get closure by ID
+// @Description Retrieves a studio closure by its ID
+// @Tags closures
+// @Produce json
+// @Param id path string true "Closure ID"
+// @Success 200 {object} responses.Response{data=models.Closure} "Closure found"
+// @Failure 404 {object} responses.Response "Closure not found"
+// @Router /closures/{id} [get]
+func (h *ClosureHandler) GetClosureByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	closure, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Closure not found")
+		return
+	}
+
+	responses.OKResponse(w, closure)
+}
+
+// DeleteClosure godoc
+// @Summary Delete a studio closure
+// @Description Reopens the studio on the days of a closure. Bookings cancelled with the closure stay cancelled.
+// @Tags closures
+// @Produce json
+// @Param id path string true "Closure ID"
+// @Success 200 {object} responses.Response "Closure deleted"
+// @Failure 404 {object} responses.Response "Closure not found"
+// @Router /closures/{id} [delete]
+func (h *ClosureHandler) DeleteClosure(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if err := h.repo.Delete(id); err != nil {
+		responses.NotFoundResponse(w, "Closure not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Closure deleted successfully", nil)
+}
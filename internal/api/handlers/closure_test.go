@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateClosure_CancelBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClosureRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClosureHandler(mockRepo, mockBookingRepo)
+
+	from := time.Date(2022, 12, 24, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 12, 26, 0, 0, 0, 0, time.UTC)
+	cancelled := []*models.Booking{{ID: "booking-1", Date: from, Status: models.BookingStatusCancelled}}
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	mockBookingRepo.EXPECT().CancelByDateRange(from, to, gomock.Any()).
+		DoAndReturn(func(_, _ time.Time, cancellation models.Cancellation) []*models.Booking {
+			assert.Equal(t, "studio closed: Christmas", cancellation.Reason)
+			return cancelled
+		})
+
+	requestBody, _ := json.Marshal(models.ClosureInput{
+		StartDate:      "2022-12-24",
+		EndDate:        "2022-12-26",
+		Reason:         "Christmas",
+		CancelBookings: true,
+	})
+	req := httptest.NewRequest("POST", "/closures", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClosure(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-1")
+}
+
+func TestCreateClosure_ListsAffectedBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClosureRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewClosureHandler(mockRepo, mockBookingRepo)
+
+	date := time.Date(2022, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	mockBookingRepo.EXPECT().Find(gomock.Any()).DoAndReturn(func(query repositories.BookingQuery) []*models.Booking {
+		assert.Equal(t, date, query.DateFrom)
+		assert.Equal(t, date, query.DateTo)
+		return []*models.Booking{{ID: "booking-1", Date: date}}
+	})
+
+	requestBody, _ := json.Marshal(models.ClosureInput{StartDate: "2022-05-02", Reason: "Maintenance"})
+	req := httptest.NewRequest("POST", "/closures", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClosure(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-1")
+}
+
+func TestCreateClosure_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewClosureHandler(mocks.NewMockClosureRepository(ctrl), mocks.NewMockBookingRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.ClosureInput{StartDate: "2022-05-02", EndDate: "2022-05-01", Reason: "Maintenance"})
+	req := httptest.NewRequest("POST", "/closures", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClosure(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestDeleteClosure_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClosureHandler(mockRepo, mocks.NewMockBookingRepository(ctrl))
+
+	mockRepo.EXPECT().Delete("missing").Return(repositories.ErrClosureNotFound)
+
+	req := httptest.NewRequest("DELETE", "/closures/missing", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "missing"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteClosure(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGetClassAvailability_SkipsClosures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	mockClosures := mocks.NewMockClosureRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mockBookingRepo, mockClosures, mocks.NewMockInstructorRepository(ctrl), mocks.NewMockRoomRepository(ctrl))
+
+	mockClass := &models.Class{
+		ID:        "test-id",
+		StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+		Capacity:  10,
+	}
+	closure := &models.Closure{StartDate: mockClass.StartDate, EndDate: mockClass.StartDate, Reason: "New Year"}
+
+	mockRepo.EXPECT().GetByID("test-id").Return(mockClass, nil)
+	mockClosures.EXPECT().ClosureOn(mockClass.StartDate).Return(closure)
+	mockClosures.EXPECT().ClosureOn(mockClass.EndDate).Return(nil)
+	mockBookingRepo.EXPECT().CountByClassAndDate("test-id", mockClass.EndDate).Return(0, 0)
+
+	req := httptest.NewRequest("GET", "/classes/test-id/availability", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "test-id"})
+	recorder := httptest.NewRecorder()
+
+	handler.GetClassAvailability(recorder, req)
+
+	var response struct {
+		Data []models.Availability `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data, 1)
+}
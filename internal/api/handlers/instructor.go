@@ -0,0 +1,172 @@
+// File: internal/api/handlers/instructor.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// InstructorHandler handles HTTP requests related to instructors
+type InstructorHandler struct {
+	repo      repositories.InstructorRepository
+	classRepo repositories.ClassRepository
+}
+
+// NewInstructorHandler creates a new InstructorHandler instance
+func NewInstructorHandler(repo repositories.InstructorRepository, classRepo repositories.ClassRepository) *InstructorHandler {
+	return &InstructorHandler{repo: repo, classRepo: classRepo}
+}
+
+// CreateInstructor godoc
+// @Summary Create a new instructor
+// @Description Creates an instructor who can be assigned to classes
+// @Tags instructors
+// @Accept json
+// @Produce json
+// @Param instructor body models.InstructorInput true "Instructor information"
+// @Success 201 {object} responses.Response{data=models.Instructor} "Instructor created successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 500 {object} responses.Response "Server error"
+// @Router /instructors [post]
+func (h *InstructorHandler) CreateInstructor(w http.ResponseWriter, r *http.Request) {
+	var input models.InstructorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	instructor, err := models.NewInstructor(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Create(instructor); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	responses.CreatedResponse(w, "Instructor created successfully", instructor)
+}
+
+// GetAllInstructors godoc
+// @Summary Get all instructors
+// @Description Retrieves all instructors ordered by name
+// @Tags instructors
+// @Produce json
+// @Success 200 {object} responses.Response{data=[]models.Instructor} "List of instructors"
+// @Router /instructors [get]
+func (h *InstructorHandler) GetAllInstructors(w http.ResponseWriter, r *http.Request) {
+	instructors := h.repo.GetAll()
+	responses.ListResponse(w, instructors, len(instructors))
+}
+
+// GetInstructorByID godoc
+// @Summary Get instructor by ID
+// @Description Retrieves an instructor by its ID
+// @Tags instructors
+// @Produce json
+// @Param id path string true "Instructor ID"
+// @Success 200 {object} responses.Response{data=models.Instructor} "Instructor found"
+// @Failure 404 {object} responses.Response "Instructor not found"
+// @Router /instructors/{id} [get]
+func (h *InstructorHandler) GetInstructorByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	instructor, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Instructor not found")
+		return
+	}
+
+	responses.OKResponse(w, instructor)
+}
+
+// UpdateInstructor godoc
+// @Summary Update an instructor
+// @Description Replaces the name, bio and contact details of an instructor
+// @Tags instructors
+// @Accept json
+// @Produce json
+// @Param id path string true "Instructor ID"
+// @Param instructor body models.InstructorInput true "Instructor information"
+// @Success 200 {object} responses.Response{data=models.Instructor} "Instructor updated successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 404 {object} responses.Response "Instructor not found"
+// @Router /instructors/{id} [put]
+func (h *InstructorHandler) UpdateInstructor(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	instructor, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Instructor not found")
+		return
+	}
+
+	var input models.InstructorInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	updated, err := instructor.Updated(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Update(updated); err != nil {
+		responses.NotFoundResponse(w, "Instructor not found")
+		return
+	}
+	h.classRepo.ReindexInstructor(updated.ID)
+
+	responses.SuccessResponse(w, http.StatusOK, "Instructor updated successfully", updated)
+}
+
+// DeleteInstructor godoc
+// @Summary Delete an instructor
+// @Description Deletes an instructor who is not assigned to any active class. Classes still taught by the instructor, including as a substitute, are returned with a conflict.
+// @Tags instructors
+// @Produce json
+// @Param id path string true "Instructor ID"
+// @Success 200 {object} responses.Response "Instructor deleted"
+// @Failure 404 {object} responses.Response "Instructor not found"
+// @Failure 409 {object} responses.Response{data=[]models.Class} "Instructor is assigned to classes"
+// @Router /instructors/{id} [delete]
+func (h *InstructorHandler) DeleteInstructor(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, err := h.repo.GetByID(id); err != nil {
+		responses.NotFoundResponse(w, "Instructor not found")
+		return
+	}
+
+	assigned := make([]*models.Class, 0)
+	for _, class := range h.classRepo.Find(repositories.ClassQuery{InstructorID: id}) {
+		if !class.IsArchived() {
+			assigned = append(assigned, class)
+		}
+	}
+	if len(assigned) > 0 {
+		responses.ConflictResponse(w, "instructor is assigned to classes, reassign them first", assigned)
+		return
+	}
+
+	if err := h.repo.Delete(id); err != nil {
+		responses.NotFoundResponse(w, "Instructor not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Instructor deleted successfully", nil)
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateInstructor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockInstructorRepository(ctrl)
+	handler := NewInstructorHandler(mockRepo, mocks.NewMockClassRepository(ctrl))
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	requestBody, _ := json.Marshal(models.InstructorInput{Name: "Jane Doe", Email: "jane@example.com"})
+	req := httptest.NewRequest("POST", "/instructors", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateInstructor(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestCreateInstructor_InvalidEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewInstructorHandler(mocks.NewMockInstructorRepository(ctrl), mocks.NewMockClassRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.InstructorInput{Name: "Jane Doe", Email: "not-an-email"})
+	req := httptest.NewRequest("POST", "/instructors", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateInstructor(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateInstructor_ReindexesClasses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockInstructorRepository(ctrl)
+	mockClassRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewInstructorHandler(mockRepo, mockClassRepo)
+
+	mockRepo.EXPECT().GetByID("instructor-1").Return(&models.Instructor{ID: "instructor-1", Name: "Jane Doe"}, nil)
+	mockRepo.EXPECT().Update(gomock.Any()).Return(nil)
+	mockClassRepo.EXPECT().ReindexInstructor("instructor-1")
+
+	requestBody, _ := json.Marshal(models.InstructorInput{Name: "Jane Smith"})
+	req := httptest.NewRequest("PUT", "/instructors/instructor-1", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "instructor-1"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateInstructor(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Jane Smith")
+}
+
+func TestDeleteInstructor_AssignedToClasses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockInstructorRepository(ctrl)
+	mockClassRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewInstructorHandler(mockRepo, mockClassRepo)
+
+	mockRepo.EXPECT().GetByID("instructor-1").Return(&models.Instructor{ID: "instructor-1"}, nil)
+	mockClassRepo.EXPECT().Find(repositories.ClassQuery{InstructorID: "instructor-1"}).
+		Return([]*models.Class{{ID: "class-1", InstructorID: "instructor-1"}})
+
+	req := httptest.NewRequest("DELETE", "/instructors/instructor-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "instructor-1"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteInstructor(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "class-1")
+}
+
+func TestCreateClass_InstructorConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockInstructors := mocks.NewMockInstructorRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mockInstructors, mocks.NewMockRoomRepository(ctrl))
+
+	date := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	mockInstructors.EXPECT().GetByID("instructor-1").Return(&models.Instructor{ID: "instructor-1"}, nil)
+	mockRepo.EXPECT().Create(gomock.Any()).Return(&repositories.InstructorConflictError{
+		InstructorID: "instructor-1",
+		ClassID:      "class-1",
+		Date:         date,
+	})
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName:    "Yoga",
+		StartDate:    "2022-01-01",
+		EndDate:      "2022-01-10",
+		Capacity:     10,
+		InstructorID: "instructor-1",
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "2022-01-03")
+}
+
+func TestCreateClass_UnknownInstructor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInstructors := mocks.NewMockInstructorRepository(ctrl)
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mockInstructors, mocks.NewMockRoomRepository(ctrl))
+
+	mockInstructors.EXPECT().GetByID("missing").Return(nil, repositories.ErrInstructorNotFound)
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName:    "Yoga",
+		StartDate:    "2022-01-01",
+		EndDate:      "2022-01-10",
+		Capacity:     10,
+		InstructorID: "missing",
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
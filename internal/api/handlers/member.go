@@ -0,0 +1,270 @@
+// File: internal/api/handlers/member.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// MemberHandler handles HTTP requests related to studio members
+type MemberHandler struct {
+	repo        repositories.MemberRepository
+	bookingRepo repositories.BookingRepository
+}
+
+// MemberBookingLink lists the bookings linked to a member by name, and the
+// ones left unlinked because the member already holds another booking for
+// the same class date.
+type MemberBookingLink struct {
+	MemberID          string   `json:"memberId"`
+	Name              string   `json:"name"`
+	Created           bool     `json:"created"`
+	BookingIDs        []string `json:"bookingIds"`
+	SkippedBookingIDs []string `json:"skippedBookingIds"`
+}
+
+// MemberLinkResult reports how bookings holding only a name were matched to
+// members. Unmatched lists the names no member has, Ambiguous the names more
+// than one member has.
+type MemberLinkResult struct {
+	Linked    []MemberBookingLink `json:"linked"`
+	Unmatched []string            `json:"unmatched"`
+	Ambiguous []string            `json:"ambiguous"`
+}
+
+// NewMemberHandler creates a new MemberHandler instance
+func NewMemberHandler(repo repositories.MemberRepository, bookingRepo repositories.BookingRepository) *MemberHandler {
+	return &MemberHandler{repo: repo, bookingRepo: bookingRepo}
+}
+
+// CreateMember godoc
+// @Summary Create a new member
+// @Description Creates a studio member who can book classes
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param member body models.MemberInput true "Member information"
+// @Success 201 {object} responses.Response{data=models.Member} "Member created successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 500 {object} responses.Response "Server error"
+// @Router /members [post]
+func (h *MemberHandler) CreateMember(w http.ResponseWriter, r *http.Request) {
+	var input models.MemberInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	member, err := models.NewMember(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Create(member); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	responses.CreatedResponse(w, "Member created successfully", member)
+}
+
+// GetAllMembers godoc
+// @Summary Get all members
+// @Description Retrieves all members ordered by name, optionally only those with a name matching case-insensitively
+// @Tags members
+// @Produce json
+// @Param name query string false "Filter by name (case-insensitive)"
+// @Success 200 {object} responses.Response{data=[]models.Member} "List of members"
+// @Router /members [get]
+func (h *MemberHandler) GetAllMembers(w http.ResponseWriter, r *http.Request) {
+	var members []*models.Member
+	if name := r.URL.Query().Get("name"); name != "" {
+		members = h.repo.FindByName(name)
+	} else {
+		members = h.repo.GetAll()
+	}
+	responses.ListResponse(w, members, len(members))
+}
+
+// GetMemberByID godoc
+// @Summary Get member by ID
+// @Description Retrieves a member by its ID
+// @Tags members
+// @Produce json
+// @Param id path string true "Member ID"
+// @Success 200 {object} responses.Response{data=models.Member} "Member found"
+// @Failure 404 {object} responses.Response "Member not found"
+// @Router /members/{id} [get]
+func (h *MemberHandler) GetMemberByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	member, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Member not found")
+		return
+	}
+
+	responses.OKResponse(w, member)
+}
+
+// UpdateMember godoc
+// @Summary Update a member
+// @Description Replaces the name, contact details and status of a member, keeping the current status when none is given. Inactive members cannot make new bookings.
+// @Tags members
+// @Accept json
+// @Produce json
+// @Param id path string true "Member ID"
+// @Param member body models.MemberInput true "Member information"
+// @Success 200 {object} responses.Response{data=models.Member} "Member updated successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 404 {object} responses.Response "Member not found"
+// @Router /members/{id} [put]
+func (h *MemberHandler) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	member, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Member not found")
+		return
+	}
+
+	var input models.MemberInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	updated, err := member.Updated(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Update(updated); err != nil {
+		responses.NotFoundResponse(w, "Member not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Member updated successfully", updated)
+}
+
+// DeleteMember godoc
+// @Summary Delete a member
+// @Description Deletes a member who holds no bookings. A member with bookings is kept for their history and can be set inactive instead.
+// @Tags members
+// @Produce json
+// @Param id path string true "Member ID"
+// @Success 200 {object} responses.Response "Member deleted"
+// @Failure 404 {object} responses.Response "Member not found"
+// @Failure 409 {object} responses.Response{data=[]models.Booking} "Member has bookings"
+// @Router /members/{id} [delete]
+func (h *MemberHandler) DeleteMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, err := h.repo.GetByID(id); err != nil {
+		responses.NotFoundResponse(w, "Member not found")
+		return
+	}
+
+	if bookings := h.bookingRepo.Find(repositories.BookingQuery{MemberID: id}); len(bookings) > 0 {
+		responses.ConflictResponse(w, "member has bookings, set the status to inactive instead", bookings)
+		return
+	}
+
+	if err := h.repo.Delete(id); err != nil {
+		responses.NotFoundResponse(w, "Member not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Member deleted successfully", nil)
+}
+
+// LinkBookings godoc
+// @Summary Link name-only bookings to members
+// @Description Matches the bookings that hold only a name to the member with that name, ignoring case and extra whitespace, and links them. Names no member has are reported as unmatched, or get a new member with create=true. Names more than one member has are reported as ambiguous and left alone. A booking for a class date the member already holds another booking for is left unlinked and reported as skipped. Safe to run again.
+// @Tags members
+// @Produce json
+// @Param create query bool false "Create a member for each unmatched name"
+// @Success 200 {object} responses.Response{data=MemberLinkResult} "Bookings linked"
+// @Failure 500 {object} responses.Response "Server error"
+// @Router /members/link-bookings [post]
+func (h *MemberHandler) LinkBookings(w http.ResponseWriter, r *http.Request) {
+	create := r.URL.Query().Get("create") == "true"
+
+	// Group the bookings by name, keeping the name as first written
+	names := make(map[string]string)
+	for _, booking := range h.bookingRepo.Find(repositories.BookingQuery{Unlinked: true}) {
+		key := models.NormalizeName(booking.Name)
+		if _, seen := names[key]; !seen {
+			names[key] = booking.Name
+		}
+	}
+	keys := make([]string, 0, len(names))
+	for key := range names {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := MemberLinkResult{
+		Linked:    make([]MemberBookingLink, 0),
+		Unmatched: make([]string, 0),
+		Ambiguous: make([]string, 0),
+	}
+	for _, key := range keys {
+		name := names[key]
+		matches := h.repo.FindByName(name)
+
+		var member *models.Member
+		switch {
+		case len(matches) == 1:
+			member = matches[0]
+		case len(matches) > 1:
+			result.Ambiguous = append(result.Ambiguous, name)
+			continue
+		case !create:
+			result.Unmatched = append(result.Unmatched, name)
+			continue
+		default:
+			created, err := models.NewMember(models.MemberInput{Name: name})
+			if err != nil {
+				result.Unmatched = append(result.Unmatched, name)
+				continue
+			}
+			if err := h.repo.Create(created); err != nil {
+				responses.InternalServerErrorResponse(w)
+				return
+			}
+			member = created
+		}
+
+		link := MemberBookingLink{
+			MemberID:          member.ID,
+			Name:              member.Name,
+			Created:           len(matches) == 0,
+			BookingIDs:        make([]string, 0),
+			SkippedBookingIDs: make([]string, 0),
+		}
+		linked, skipped := h.bookingRepo.LinkMember(name, member.ID)
+		for _, booking := range linked {
+			link.BookingIDs = append(link.BookingIDs, booking.ID)
+		}
+		for _, booking := range skipped {
+			link.SkippedBookingIDs = append(link.SkippedBookingIDs, booking.ID)
+		}
+		result.Linked = append(result.Linked, link)
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Bookings linked to members", result)
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockMemberRepository(ctrl)
+	handler := NewMemberHandler(mockRepo, mocks.NewMockBookingRepository(ctrl))
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	requestBody, _ := json.Marshal(models.MemberInput{Name: "John Doe", Email: "john@example.com"})
+	req := httptest.NewRequest("POST", "/members", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateMember(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"status":"active"`)
+}
+
+func TestCreateMember_InvalidStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewMemberHandler(mocks.NewMockMemberRepository(ctrl), mocks.NewMockBookingRepository(ctrl))
+
+	requestBody, _ := json.Marshal(models.MemberInput{Name: "John Doe", Status: "paused"})
+	req := httptest.NewRequest("POST", "/members", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateMember(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUpdateMember_KeepsStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockMemberRepository(ctrl)
+	handler := NewMemberHandler(mockRepo, mocks.NewMockBookingRepository(ctrl))
+
+	mockRepo.EXPECT().GetByID("member-1").Return(&models.Member{ID: "member-1", Name: "John Doe", Status: models.MemberStatusInactive}, nil)
+	mockRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(member *models.Member) error {
+		assert.Equal(t, models.MemberStatusInactive, member.Status)
+		return nil
+	})
+
+	// An update without a status does not reactivate the member
+	requestBody, _ := json.Marshal(models.MemberInput{Name: "John Doe", Email: "john@example.com"})
+	req := httptest.NewRequest("PUT", "/members/member-1", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "member-1"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateMember(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"status":"inactive"`)
+}
+
+func TestDeleteMember_HasBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockMemberRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewMemberHandler(mockRepo, mockBookingRepo)
+
+	mockRepo.EXPECT().GetByID("member-1").Return(&models.Member{ID: "member-1"}, nil)
+	mockBookingRepo.EXPECT().Find(repositories.BookingQuery{MemberID: "member-1"}).
+		Return([]*models.Booking{{ID: "booking-1", MemberID: "member-1"}})
+
+	req := httptest.NewRequest("DELETE", "/members/member-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "member-1"})
+	recorder := httptest.NewRecorder()
+
+	handler.DeleteMember(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "booking-1")
+}
+
+func TestLinkBookings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockMemberRepository(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepository(ctrl)
+	handler := NewMemberHandler(mockRepo, mockBookingRepo)
+
+	mockBookingRepo.EXPECT().Find(repositories.BookingQuery{Unlinked: true}).Return([]*models.Booking{
+		{ID: "booking-1", Name: "John Doe"},
+		{ID: "booking-2", Name: "john  doe"},
+		{ID: "booking-3", Name: "Jane Roe"},
+		{ID: "booking-4", Name: "Sam Poe"},
+	})
+	mockRepo.EXPECT().FindByName("Jane Roe").Return([]*models.Member{})
+	mockRepo.EXPECT().FindByName("John Doe").Return([]*models.Member{{ID: "member-1", Name: "John Doe"}})
+	mockRepo.EXPECT().FindByName("Sam Poe").Return([]*models.Member{{ID: "member-2"}, {ID: "member-3"}})
+	mockBookingRepo.EXPECT().LinkMember("John Doe", "member-1").Return([]*models.Booking{
+		{ID: "booking-1", MemberID: "member-1"},
+		{ID: "booking-2", MemberID: "member-1"},
+	}, []*models.Booking{
+		{ID: "booking-5"},
+	})
+
+	req := httptest.NewRequest("POST", "/members/link-bookings", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.LinkBookings(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response struct {
+		Data MemberLinkResult `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Data.Linked, 1)
+	assert.Equal(t, []string{"booking-1", "booking-2"}, response.Data.Linked[0].BookingIDs)
+	assert.Equal(t, []string{"booking-5"}, response.Data.Linked[0].SkippedBookingIDs)
+	assert.Equal(t, []string{"Jane Roe"}, response.Data.Unmatched)
+	assert.Equal(t, []string{"Sam Poe"}, response.Data.Ambiguous)
+}
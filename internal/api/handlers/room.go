@@ -0,0 +1,188 @@
+// File: internal/api/handlers/room.go
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"glofox-backend/internal/api/responses"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/gorilla/mux"
+)
+
+// RoomHandler handles HTTP requests related to studio rooms
+type RoomHandler struct {
+	repo      repositories.RoomRepository
+	classRepo repositories.ClassRepository
+}
+
+// NewRoomHandler creates a new RoomHandler instance
+func NewRoomHandler(repo repositories.RoomRepository, classRepo repositories.ClassRepository) *RoomHandler {
+	return &RoomHandler{repo: repo, classRepo: classRepo}
+}
+
+// CreateRoom godoc
+// @Summary Create a new room
+// @Description Creates a studio room that classes can be held in
+// @Tags rooms
+// @Accept json
+// @Produce json
+// @Param room body models.RoomInput true "Room information"
+// @Success 201 {object} responses.Response{data=models.Room} "Room created successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 500 {object} responses.Response "Server error"
+// @Router /rooms [post]
+func (h *RoomHandler) CreateRoom(w http.ResponseWriter, r *http.Request) {
+	var input models.RoomInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	room, err := models.NewRoom(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	if err := h.repo.Create(room); err != nil {
+		responses.InternalServerErrorResponse(w)
+		return
+	}
+
+	responses.CreatedResponse(w, "Room created successfully", room)
+}
+
+// GetAllRooms godoc
+// @Summary Get all rooms
+// @Description Retrieves all studio rooms ordered by name
+// @Tags rooms
+// @Produce json
+// @Success 200 {object} responses.Response{data=[]models.Room} "List of rooms"
+// @Router /rooms [get]
+func (h *RoomHandler) GetAllRooms(w http.ResponseWriter, r *http.Request) {
+	rooms := h.repo.GetAll()
+	responses.ListResponse(w, rooms, len(rooms))
+}
+
+// GetRoomByID godoc
+// @Summary Get room by ID
+// @Description Retrieves a studio room by its ID
+// @Tags rooms
+// @Produce json
+// @Param id path string true "Room ID"
+// @Success 200 {object} responses.Response{data=models.Room} "Room found"
+// @Failure 404 {object} responses.Response "Room not found"
+// @Router /rooms/{id} [get]
+func (h *RoomHandler) GetRoomByID(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	room, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Room not found")
+		return
+	}
+
+	responses.OKResponse(w, room)
+}
+
+// UpdateRoom godoc
+// @Summary Update a room
+// @Description Replaces the name, description and capacity of a room. Reducing the capacity below that of a class held in the room is rejected.
+// @Tags rooms
+// @Accept json
+// @Produce json
+// @Param id path string true "Room ID"
+// @Param room body models.RoomInput true "Room information"
+// @Success 200 {object} responses.Response{data=models.Room} "Room updated successfully"
+// @Failure 400 {object} responses.Response "Invalid input"
+// @Failure 404 {object} responses.Response "Room not found"
+// @Failure 409 {object} responses.Response{data=[]models.Class} "Classes in the room no longer fit"
+// @Router /rooms/{id} [put]
+func (h *RoomHandler) UpdateRoom(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	room, err := h.repo.GetByID(id)
+	if err != nil {
+		responses.NotFoundResponse(w, "Room not found")
+		return
+	}
+
+	var input models.RoomInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		responses.BadRequestResponse(w, "Invalid input: "+err.Error())
+		return
+	}
+
+	updated, err := room.Updated(input)
+	if err != nil {
+		responses.BadRequestResponse(w, err.Error())
+		return
+	}
+
+	oversized := make([]*models.Class, 0)
+	for _, class := range h.activeClasses(id) {
+		if updated.Fits(class) != nil {
+			oversized = append(oversized, class)
+		}
+	}
+	if len(oversized) > 0 {
+		responses.ConflictResponse(w, "classes in the room hold more people than the new capacity, reduce their capacity first", oversized)
+		return
+	}
+
+	if err := h.repo.Update(updated); err != nil {
+		responses.NotFoundResponse(w, "Room not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Room updated successfully", updated)
+}
+
+// DeleteRoom godoc
+// @Summary Delete a room
+// @Description Deletes a room that no active class is held in. Classes still held in the room are returned with a conflict.
+// @Tags rooms
+// @Produce json
+// @Param id path string true "Room ID"
+// @Success 200 {object} responses.Response "Room deleted"
+// @Failure 404 {object} responses.Response "Room not found"
+// @Failure 409 {object} responses.Response{data=[]models.Class} "Room is assigned to classes"
+// @Router /rooms/{id} [delete]
+func (h *RoomHandler) DeleteRoom(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, err := h.repo.GetByID(id); err != nil {
+		responses.NotFoundResponse(w, "Room not found")
+		return
+	}
+
+	if assigned := h.activeClasses(id); len(assigned) > 0 {
+		responses.ConflictResponse(w, "room is assigned to classes, move them first", assigned)
+		return
+	}
+
+	if err := h.repo.Delete(id); err != nil {
+		responses.NotFoundResponse(w, "Room not found")
+		return
+	}
+
+	responses.SuccessResponse(w, http.StatusOK, "Room deleted successfully", nil)
+}
+
+// activeClasses returns the classes held in a room that are not archived
+func (h *RoomHandler) activeClasses(roomID string) []*models.Class {
+	classes := make([]*models.Class, 0)
+	for _, class := range h.classRepo.Find(repositories.ClassQuery{RoomID: roomID}) {
+		if !class.IsArchived() {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/mocks"
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRoom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRoomRepository(ctrl)
+	handler := NewRoomHandler(mockRepo, mocks.NewMockClassRepository(ctrl))
+
+	mockRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	requestBody, _ := json.Marshal(models.RoomInput{Name: "Studio A", Capacity: 20})
+	req := httptest.NewRequest("POST", "/rooms", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateRoom(recorder, req)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestUpdateRoom_ClassesNoLongerFit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRoomRepository(ctrl)
+	mockClassRepo := mocks.NewMockClassRepository(ctrl)
+	handler := NewRoomHandler(mockRepo, mockClassRepo)
+
+	mockRepo.EXPECT().GetByID("room-1").Return(&models.Room{ID: "room-1", Name: "Studio A", Capacity: 20}, nil)
+	mockClassRepo.EXPECT().Find(repositories.ClassQuery{RoomID: "room-1"}).
+		Return([]*models.Class{{ID: "class-1", RoomID: "room-1", Capacity: 15}})
+
+	requestBody, _ := json.Marshal(models.RoomInput{Name: "Studio A", Capacity: 10})
+	req := httptest.NewRequest("PUT", "/rooms/room-1", bytes.NewBuffer(requestBody))
+	req = mux.SetURLVars(req, map[string]string{"id": "room-1"})
+	recorder := httptest.NewRecorder()
+
+	handler.UpdateRoom(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "class-1")
+}
+
+func TestCreateClass_AboveRoomCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRooms := mocks.NewMockRoomRepository(ctrl)
+	handler := NewClassHandler(mocks.NewMockClassRepository(ctrl), mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mockRooms)
+
+	mockRooms.EXPECT().GetByID("room-1").Return(&models.Room{ID: "room-1", Name: "Studio A", Capacity: 12}, nil)
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Spin",
+		StartDate: "2022-01-01",
+		EndDate:   "2022-01-10",
+		Capacity:  20,
+		RoomID:    "room-1",
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateClass_RoomInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockClassRepository(ctrl)
+	mockRooms := mocks.NewMockRoomRepository(ctrl)
+	handler := NewClassHandler(mockRepo, mocks.NewMockBookingRepository(ctrl), mocks.NewMockClosureRepository(ctrl), mocks.NewMockInstructorRepository(ctrl), mockRooms)
+
+	mockRooms.EXPECT().GetByID("room-1").Return(&models.Room{ID: "room-1", Name: "Studio A", Capacity: 20}, nil)
+	mockRepo.EXPECT().Create(gomock.Any()).Return(&repositories.RoomConflictError{
+		RoomID:  "room-1",
+		ClassID: "class-1",
+		Date:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	requestBody, _ := json.Marshal(models.ClassInput{
+		ClassName: "Spin",
+		StartDate: "2022-01-01",
+		EndDate:   "2022-01-10",
+		Capacity:  20,
+		RoomID:    "room-1",
+	})
+	req := httptest.NewRequest("POST", "/classes", bytes.NewBuffer(requestBody))
+	recorder := httptest.NewRecorder()
+
+	handler.CreateClass(recorder, req)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "class-1")
+}
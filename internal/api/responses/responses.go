@@ -56,6 +56,14 @@ func BadRequestResponse(w http.ResponseWriter, message string) {
 	ErrorResponse(w, http.StatusBadRequest, message)
 }
 
+func ConflictResponse(w http.ResponseWriter, message string, data interface{}) {
+	WriteJSON(w, http.StatusConflict, Response{
+		Success: false,
+		Message: message,
+		Data:    data,
+	})
+}
+
 func NotFoundResponse(w http.ResponseWriter, message string) {
 	ErrorResponse(w, http.StatusNotFound, message)
 }
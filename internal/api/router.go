@@ -10,7 +10,7 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func SetupRouter(classHandler *handlers.ClassHandler, bookingHandler *handlers.BookingHandler) *mux.Router {
+func SetupRouter(classHandler *handlers.ClassHandler, bookingHandler *handlers.BookingHandler, seriesHandler *handlers.BookingSeriesHandler, checkInHandler *handlers.CheckInHandler, closureHandler *handlers.ClosureHandler, instructorHandler *handlers.InstructorHandler, roomHandler *handlers.RoomHandler, memberHandler *handlers.MemberHandler) *mux.Router {
 	router := mux.NewRouter()
 
 	router.Use(middleware.Logger)
@@ -29,11 +29,62 @@ func SetupRouter(classHandler *handlers.ClassHandler, bookingHandler *handlers.B
 
 	router.HandleFunc("/classes", classHandler.CreateClass).Methods("POST")
 	router.HandleFunc("/classes", classHandler.GetAllClasses).Methods("GET")
+	// Registered before /classes/{id} so "search" is not taken for an ID
+	router.HandleFunc("/classes/search", classHandler.SearchClasses).Methods("GET")
 	router.HandleFunc("/classes/{id}", classHandler.GetClassByID).Methods("GET")
+	router.HandleFunc("/classes/{id}", classHandler.UpdateClass).Methods("PUT")
+	router.HandleFunc("/classes/{id}", classHandler.PatchClass).Methods("PATCH")
+	router.HandleFunc("/classes/{id}", classHandler.DeleteClass).Methods("DELETE")
+	router.HandleFunc("/classes/{id}/availability", classHandler.GetClassAvailability).Methods("GET")
+	router.HandleFunc("/classes/{id}/occurrences", classHandler.GetClassOccurrences).Methods("GET")
+	router.HandleFunc("/classes/{id}/overrides", classHandler.GetClassOverrides).Methods("GET")
+	router.HandleFunc("/classes/{id}/overrides/{date}", classHandler.SetClassOverride).Methods("PUT")
+	router.HandleFunc("/classes/{id}/overrides/{date}", classHandler.DeleteClassOverride).Methods("DELETE")
 
 	router.HandleFunc("/bookings", bookingHandler.CreateBooking).Methods("POST")
 	router.HandleFunc("/bookings", bookingHandler.GetAllBookings).Methods("GET")
 	router.HandleFunc("/bookings/{id}", bookingHandler.GetBookingByID).Methods("GET")
+	router.HandleFunc("/bookings/{id}", bookingHandler.CancelBooking).Methods("DELETE")
+	router.HandleFunc("/bookings/{id}/reschedule", bookingHandler.RescheduleBooking).Methods("POST")
+	router.HandleFunc("/bookings/{id}/status", bookingHandler.UpdateBookingStatus).Methods("PATCH")
+	router.HandleFunc("/bookings/{id}/check-in", checkInHandler.CheckInBooking).Methods("POST")
+	router.HandleFunc("/bookings/{id}/check-in-token", checkInHandler.IssueCheckInToken).Methods("GET")
+
+	router.HandleFunc("/booking-series", seriesHandler.CreateBookingSeries).Methods("POST")
+	router.HandleFunc("/booking-series", seriesHandler.GetAllBookingSeries).Methods("GET")
+	router.HandleFunc("/booking-series/{id}", seriesHandler.GetBookingSeriesByID).Methods("GET")
+	router.HandleFunc("/booking-series/{id}", seriesHandler.CancelBookingSeries).Methods("DELETE")
+
+	router.HandleFunc("/check-in", checkInHandler.CheckInWithToken).Methods("POST")
+	router.HandleFunc("/check-in/roster", checkInHandler.CheckInRoster).Methods("POST")
+
+	router.HandleFunc("/closures", closureHandler.CreateClosure).Methods("POST")
+	router.HandleFunc("/closures", closureHandler.GetAllClosures).Methods("GET")
+	router.HandleFunc("/closures/{id}", closureHandler.GetClosureByID).Methods("GET")
+	router.HandleFunc("/closures/{id}", closureHandler.DeleteClosure).Methods("DELETE")
+
+	router.HandleFunc("/instructors", instructorHandler.CreateInstructor).Methods("POST")
+	router.HandleFunc("/instructors", instructorHandler.GetAllInstructors).Methods("GET")
+	router.HandleFunc("/instructors/{id}", instructorHandler.GetInstructorByID).Methods("GET")
+	router.HandleFunc("/instructors/{id}", instructorHandler.UpdateInstructor).Methods("PUT")
+	router.HandleFunc("/instructors/{id}", instructorHandler.DeleteInstructor).Methods("DELETE")
+
+	router.HandleFunc("/rooms", roomHandler.CreateRoom).Methods("POST")
+	router.HandleFunc("/rooms", roomHandler.GetAllRooms).Methods("GET")
+	router.HandleFunc("/rooms/{id}", roomHandler.GetRoomByID).Methods("GET")
+	router.HandleFunc("/rooms/{id}", roomHandler.UpdateRoom).Methods("PUT")
+	router.HandleFunc("/rooms/{id}", roomHandler.DeleteRoom).Methods("DELETE")
+
+	router.HandleFunc("/members", memberHandler.CreateMember).Methods("POST")
+	router.HandleFunc("/members", memberHandler.GetAllMembers).Methods("GET")
+	router.HandleFunc("/members/link-bookings", memberHandler.LinkBookings).Methods("POST")
+	router.HandleFunc("/members/{id}", memberHandler.GetMemberByID).Methods("GET")
+	router.HandleFunc("/members/{id}", memberHandler.UpdateMember).Methods("PUT")
+	router.HandleFunc("/members/{id}", memberHandler.DeleteMember).Methods("DELETE")
+
+	router.HandleFunc("/waitlist", bookingHandler.GetWaitlist).Methods("GET")
+	router.HandleFunc("/waitlist/promotions", bookingHandler.GetPromotions).Methods("GET")
+	router.HandleFunc("/waitlist/{id}", bookingHandler.GetWaitlistEntry).Methods("GET")
 
 	return router
 }
@@ -0,0 +1,134 @@
+package attendance
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+)
+
+const (
+	// cancellationReason is recorded on occurrences and bookings cancelled
+	// for not reaching the minimum attendance
+	cancellationReason = "minimum attendance not reached"
+	// cancelledBy identifies the evaluator on the cancellations it records
+	cancelledBy = "system"
+)
+
+// CancelledOccurrence is a class occurrence cancelled for having fewer
+// bookings than the class minimum attendance at its cutoff
+type CancelledOccurrence struct {
+	OccurrenceID      string            `json:"occurrenceId"`
+	ClassID           string            `json:"classId"`
+	Date              time.Time         `json:"date"`
+	Booked            int               `json:"booked"`
+	MinAttendance     int               `json:"minAttendance"`
+	CancelledBookings []*models.Booking `json:"cancelledBookings"`
+}
+
+// Evaluator checks each upcoming occurrence of the classes with a minimum
+// attendance once its cutoff has passed, and cancels it with its bookings
+// when it has fewer bookings than the minimum
+type Evaluator struct {
+	classes  repositories.ClassRepository
+	bookings repositories.BookingRepository
+	interval time.Duration
+
+	mutex sync.Mutex
+	// evaluated holds the session start of the occurrences already checked,
+	// keyed by occurrence ID, so each is checked only once
+	evaluated map[string]time.Time
+}
+
+func NewEvaluator(classes repositories.ClassRepository, bookings repositories.BookingRepository, interval time.Duration) *Evaluator {
+	return &Evaluator{
+		classes:   classes,
+		bookings:  bookings,
+		interval:  interval,
+		evaluated: make(map[string]time.Time),
+	}
+}
+
+// Run evaluates the occurrences every interval until the context is done
+func (e *Evaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, cancelled := range e.Evaluate(time.Now()) {
+			log.Printf("Cancelled occurrence %s with %d/%d bookings, %d bookings cancelled",
+				cancelled.OccurrenceID, cancelled.Booked, cancelled.MinAttendance, len(cancelled.CancelledBookings))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate checks the occurrences whose cutoff has passed by now but that
+// have not started yet, and returns the ones it cancelled
+func (e *Evaluator) Evaluate(now time.Time) []CancelledOccurrence {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	for id, start := range e.evaluated {
+		if !start.After(now) {
+			delete(e.evaluated, id)
+		}
+	}
+
+	cancelled := make([]CancelledOccurrence, 0)
+	for _, class := range e.classes.GetAll() {
+		if class.IsArchived() || !class.HasMinAttendance() {
+			continue
+		}
+
+		// Only occurrences starting within the cutoff can be due
+		to := now.Add(time.Duration(class.CutoffMinutes) * time.Minute)
+		dates, err := class.Dates(models.DateOf(now), to)
+		if err != nil {
+			continue
+		}
+
+		for _, date := range dates {
+			start := class.SessionOn(date).Start
+			if now.Before(class.AttendanceCutoffOn(date)) || !now.Before(start) {
+				continue
+			}
+
+			id := models.OccurrenceID(class.ID, date)
+			if _, done := e.evaluated[id]; done {
+				continue
+			}
+			e.evaluated[id] = start
+
+			booked, under, bookings, err := e.bookings.CancelUnderAttended(class.ID, date, models.Cancellation{
+				CancelledBy: cancelledBy,
+				Reason:      cancellationReason,
+				CancelledAt: now,
+			})
+			if err != nil {
+				log.Printf("Failed to cancel occurrence %s: %v", id, err)
+				continue
+			}
+			if !under {
+				continue
+			}
+			cancelled = append(cancelled, CancelledOccurrence{
+				OccurrenceID:      id,
+				ClassID:           class.ID,
+				Date:              date,
+				Booked:            booked,
+				MinAttendance:     class.MinAttendance,
+				CancelledBookings: bookings,
+			})
+		}
+	}
+	return cancelled
+}
@@ -0,0 +1,95 @@
+package attendance
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/models"
+	"glofox-backend/internal/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	// The occurrence starts at 18:00 with a cutoff at 16:00
+	start := time.Date(2030, 3, 4, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		bookings int
+		// earlier are the times the evaluator already ran at
+		earlier   []time.Time
+		now       time.Time
+		cancelled bool
+	}{
+		{"before the cutoff", 1, nil, start.Add(-2*time.Hour - time.Minute), false},
+		{"at the cutoff", 1, nil, start.Add(-2 * time.Hour), true},
+		{"inside the window", 1, nil, start.Add(-30 * time.Minute), true},
+		{"at the start", 1, nil, start, false},
+		{"exactly at the minimum", 2, nil, start.Add(-30 * time.Minute), false},
+		{"no bookings", 0, nil, start.Add(-30 * time.Minute), true},
+		{"already evaluated", 2, []time.Time{start.Add(-time.Hour)}, start.Add(-30 * time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closures := repositories.NewClosureRepository()
+			classes := repositories.NewClassRepository(closures, repositories.NewInstructorRepository())
+			bookings := repositories.NewBookingRepository(classes, closures)
+			evaluator := NewEvaluator(classes, bookings, time.Minute)
+
+			class, err := models.NewClass(models.ClassInput{
+				ClassName:       "Evening Yoga",
+				StartDate:       "2030-01-01",
+				EndDate:         "2030-12-31",
+				StartTime:       "18:00",
+				DurationMinutes: 60,
+				Capacity:        10,
+				MinAttendance:   2,
+				CutoffMinutes:   120,
+			})
+			require.NoError(t, err)
+			require.NoError(t, classes.Create(class))
+
+			for i := 0; i < tt.bookings; i++ {
+				booking, err := models.NewBooking(models.BookingInput{
+					Name:    fmt.Sprintf("Member %d", i),
+					ClassID: class.ID,
+					Date:    "2030-03-04",
+				})
+				require.NoError(t, err)
+				require.NoError(t, bookings.Create(booking))
+			}
+
+			for _, at := range tt.earlier {
+				require.Empty(t, evaluator.Evaluate(at))
+			}
+			// An occurrence checked once stays put even if a member leaves
+			if len(tt.earlier) > 0 {
+				booked := bookings.GetByClassAndDate(class.ID, start)
+				_, err := bookings.Cancel(booked[0].ID, &models.Cancellation{CancelledAt: tt.now})
+				require.NoError(t, err)
+			}
+
+			cancelled := evaluator.Evaluate(tt.now)
+
+			stored, err := classes.GetByID(class.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.cancelled, stored.IsCancelledOn(start))
+			if !tt.cancelled {
+				assert.Empty(t, cancelled)
+				return
+			}
+
+			require.Len(t, cancelled, 1)
+			assert.Equal(t, models.OccurrenceID(class.ID, start), cancelled[0].OccurrenceID)
+			assert.Equal(t, tt.bookings, cancelled[0].Booked)
+			assert.Len(t, cancelled[0].CancelledBookings, tt.bookings)
+			for _, booking := range cancelled[0].CancelledBookings {
+				assert.Equal(t, models.BookingStatusCancelled, booking.Status)
+				assert.Equal(t, cancellationReason, booking.Cancellation.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package checkin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid check-in token")
+	ErrTokenExpired = errors.New("check-in token has expired")
+)
+
+// Token is a signed, time-limited proof that a member holds a booking. It is
+// meant to be rendered as a QR code and scanned at the front desk.
+type Token struct {
+	Token     string    `json:"token"`
+	BookingID string    `json:"bookingId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// TokenSigner issues and verifies HMAC-SHA256 signed check-in tokens
+type TokenSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewTokenSigner(secret []byte, ttl time.Duration) *TokenSigner {
+	return &TokenSigner{secret: secret, ttl: ttl}
+}
+
+// Issue creates a token for the booking that expires after the signer's TTL
+func (s *TokenSigner) Issue(bookingID string, now time.Time) Token {
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	payload := bookingID + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+
+	return Token{
+		Token:     encode([]byte(payload)) + "." + encode(s.sign(payload)),
+		BookingID: bookingID,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// Verify checks the token signature and expiry and returns the booking ID
+func (s *TokenSigner) Verify(token string, now time.Time) (string, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return "", ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if !hmac.Equal(signature, s.sign(string(payload))) {
+		return "", ErrInvalidToken
+	}
+
+	bookingID, expiry, found := strings.Cut(string(payload), ".")
+	if !found {
+		return "", ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if now.After(time.Unix(expiresAt, 0)) {
+		return "", ErrTokenExpired
+	}
+
+	return bookingID, nil
+}
+
+func (s *TokenSigner) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	models "glofox-backend/internal/models"
+	repositories "glofox-backend/internal/repositories"
 	reflect "reflect"
 	time "time"
 
@@ -35,6 +36,84 @@ func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApplyClassUpdate mocks base method.
+func (m *MockBookingRepository) ApplyClassUpdate(classID string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyClassUpdate", classID, update, options)
+	ret0, _ := ret[0].(*models.Class)
+	ret1, _ := ret[1].([]models.BookingConflict)
+	ret2, _ := ret[2].([]models.OverbookingReport)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ApplyClassUpdate indicates an expected call of ApplyClassUpdate.
+func (mr *MockBookingRepositoryMockRecorder) ApplyClassUpdate(classID, update, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyClassUpdate", reflect.TypeOf((*MockBookingRepository)(nil).ApplyClassUpdate), classID, update, options)
+}
+
+// Cancel mocks base method.
+func (m *MockBookingRepository) Cancel(id string, cancellation *models.Cancellation) (*models.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", id, cancellation)
+	ret0, _ := ret[0].(*models.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockBookingRepositoryMockRecorder) Cancel(id, cancellation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockBookingRepository)(nil).Cancel), id, cancellation)
+}
+
+// CancelByDateRange mocks base method.
+func (m *MockBookingRepository) CancelByDateRange(from, to time.Time, cancellation models.Cancellation) []*models.Booking {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelByDateRange", from, to, cancellation)
+	ret0, _ := ret[0].([]*models.Booking)
+	return ret0
+}
+
+// CancelByDateRange indicates an expected call of CancelByDateRange.
+func (mr *MockBookingRepositoryMockRecorder) CancelByDateRange(from, to, cancellation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelByDateRange", reflect.TypeOf((*MockBookingRepository)(nil).CancelByDateRange), from, to, cancellation)
+}
+
+// CancelUnderAttended mocks base method.
+func (m *MockBookingRepository) CancelUnderAttended(classID string, date time.Time, cancellation models.Cancellation) (int, bool, []*models.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUnderAttended", classID, date, cancellation)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].([]*models.Booking)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CancelUnderAttended indicates an expected call of CancelUnderAttended.
+func (mr *MockBookingRepositoryMockRecorder) CancelUnderAttended(classID, date, cancellation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnderAttended", reflect.TypeOf((*MockBookingRepository)(nil).CancelUnderAttended), classID, date, cancellation)
+}
+
+// CountByClassAndDate mocks base method.
+func (m *MockBookingRepository) CountByClassAndDate(classID string, date time.Time) (int, int) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByClassAndDate", classID, date)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// CountByClassAndDate indicates an expected call of CountByClassAndDate.
+func (mr *MockBookingRepositoryMockRecorder) CountByClassAndDate(classID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByClassAndDate", reflect.TypeOf((*MockBookingRepository)(nil).CountByClassAndDate), classID, date)
+}
+
 // Create mocks base method.
 func (m *MockBookingRepository) Create(booking *models.Booking) error {
 	m.ctrl.T.Helper()
@@ -49,6 +128,35 @@ func (mr *MockBookingRepositoryMockRecorder) Create(booking interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingRepository)(nil).Create), booking)
 }
 
+// CreateOrWaitlist mocks base method.
+func (m *MockBookingRepository) CreateOrWaitlist(booking *models.Booking) (*models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrWaitlist", booking)
+	ret0, _ := ret[0].(*models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrWaitlist indicates an expected call of CreateOrWaitlist.
+func (mr *MockBookingRepositoryMockRecorder) CreateOrWaitlist(booking interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrWaitlist", reflect.TypeOf((*MockBookingRepository)(nil).CreateOrWaitlist), booking)
+}
+
+// Find mocks base method.
+func (m *MockBookingRepository) Find(query repositories.BookingQuery) []*models.Booking {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", query)
+	ret0, _ := ret[0].([]*models.Booking)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockBookingRepositoryMockRecorder) Find(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockBookingRepository)(nil).Find), query)
+}
+
 // GetAll mocks base method.
 func (m *MockBookingRepository) GetAll() []*models.Booking {
 	m.ctrl.T.Helper()
@@ -91,3 +199,138 @@ func (mr *MockBookingRepositoryMockRecorder) GetByID(id interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBookingRepository)(nil).GetByID), id)
 }
+
+// GetPromotions mocks base method.
+func (m *MockBookingRepository) GetPromotions(classID string, date time.Time) []*models.WaitlistEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotions", classID, date)
+	ret0, _ := ret[0].([]*models.WaitlistEntry)
+	return ret0
+}
+
+// GetPromotions indicates an expected call of GetPromotions.
+func (mr *MockBookingRepositoryMockRecorder) GetPromotions(classID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotions", reflect.TypeOf((*MockBookingRepository)(nil).GetPromotions), classID, date)
+}
+
+// GetWaitlist mocks base method.
+func (m *MockBookingRepository) GetWaitlist(classID string, date time.Time) []*models.WaitlistEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlist", classID, date)
+	ret0, _ := ret[0].([]*models.WaitlistEntry)
+	return ret0
+}
+
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockBookingRepositoryMockRecorder) GetWaitlist(classID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockBookingRepository)(nil).GetWaitlist), classID, date)
+}
+
+// GetWaitlistEntry mocks base method.
+func (m *MockBookingRepository) GetWaitlistEntry(id string) (*models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistEntry", id)
+	ret0, _ := ret[0].(*models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistEntry indicates an expected call of GetWaitlistEntry.
+func (mr *MockBookingRepositoryMockRecorder) GetWaitlistEntry(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistEntry", reflect.TypeOf((*MockBookingRepository)(nil).GetWaitlistEntry), id)
+}
+
+// LinkMember mocks base method.
+func (m *MockBookingRepository) LinkMember(name, memberID string) ([]*models.Booking, []*models.Booking) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkMember", name, memberID)
+	ret0, _ := ret[0].([]*models.Booking)
+	ret1, _ := ret[1].([]*models.Booking)
+	return ret0, ret1
+}
+
+// LinkMember indicates an expected call of LinkMember.
+func (mr *MockBookingRepositoryMockRecorder) LinkMember(name, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkMember", reflect.TypeOf((*MockBookingRepository)(nil).LinkMember), name, memberID)
+}
+
+// PromoteWaitlist mocks base method.
+func (m *MockBookingRepository) PromoteWaitlist(classID string, date time.Time) ([]*models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteWaitlist", classID, date)
+	ret0, _ := ret[0].([]*models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteWaitlist indicates an expected call of PromoteWaitlist.
+func (mr *MockBookingRepositoryMockRecorder) PromoteWaitlist(classID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteWaitlist", reflect.TypeOf((*MockBookingRepository)(nil).PromoteWaitlist), classID, date)
+}
+
+// RemoveClass mocks base method.
+func (m *MockBookingRepository) RemoveClass(classID string, mode models.ClassRemovalMode, cancellation models.Cancellation) ([]*models.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveClass", classID, mode, cancellation)
+	ret0, _ := ret[0].([]*models.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveClass indicates an expected call of RemoveClass.
+func (mr *MockBookingRepositoryMockRecorder) RemoveClass(classID, mode, cancellation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClass", reflect.TypeOf((*MockBookingRepository)(nil).RemoveClass), classID, mode, cancellation)
+}
+
+// Reschedule mocks base method.
+func (m *MockBookingRepository) Reschedule(id, classID string, date, start time.Time) (*models.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", id, classID, date, start)
+	ret0, _ := ret[0].(*models.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockBookingRepositoryMockRecorder) Reschedule(id, classID, date, start interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockBookingRepository)(nil).Reschedule), id, classID, date, start)
+}
+
+// SetClassOverride mocks base method.
+func (m *MockBookingRepository) SetClassOverride(classID string, date time.Time, override *models.ClassOverride, options models.ClassChangeOptions) ([]*models.Booking, []models.BookingConflict, []models.OverbookingReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClassOverride", classID, date, override, options)
+	ret0, _ := ret[0].([]*models.Booking)
+	ret1, _ := ret[1].([]models.BookingConflict)
+	ret2, _ := ret[2].([]models.OverbookingReport)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SetClassOverride indicates an expected call of SetClassOverride.
+func (mr *MockBookingRepositoryMockRecorder) SetClassOverride(classID, date, override, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClassOverride", reflect.TypeOf((*MockBookingRepository)(nil).SetClassOverride), classID, date, override, options)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockBookingRepository) UpdateStatus(id string, status models.BookingStatus) (*models.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", id, status)
+	ret0, _ := ret[0].(*models.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockBookingRepositoryMockRecorder) UpdateStatus(id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockBookingRepository)(nil).UpdateStatus), id, status)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repositories/booking_series.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "glofox-backend/internal/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBookingSeriesRepository is a mock of BookingSeriesRepository interface.
+type MockBookingSeriesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingSeriesRepositoryMockRecorder
+}
+
+// MockBookingSeriesRepositoryMockRecorder is the mock recorder for MockBookingSeriesRepository.
+type MockBookingSeriesRepositoryMockRecorder struct {
+	mock *MockBookingSeriesRepository
+}
+
+// NewMockBookingSeriesRepository creates a new mock instance.
+func NewMockBookingSeriesRepository(ctrl *gomock.Controller) *MockBookingSeriesRepository {
+	mock := &MockBookingSeriesRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingSeriesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingSeriesRepository) EXPECT() *MockBookingSeriesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBookingSeriesRepository) Create(series *models.BookingSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBookingSeriesRepositoryMockRecorder) Create(series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBookingSeriesRepository)(nil).Create), series)
+}
+
+// Delete mocks base method.
+func (m *MockBookingSeriesRepository) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBookingSeriesRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBookingSeriesRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockBookingSeriesRepository) GetAll() []*models.BookingSeries {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*models.BookingSeries)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBookingSeriesRepositoryMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBookingSeriesRepository)(nil).GetAll))
+}
+
+// GetByID mocks base method.
+func (m *MockBookingSeriesRepository) GetByID(id string) (*models.BookingSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.BookingSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBookingSeriesRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBookingSeriesRepository)(nil).GetByID), id)
+}
+
+// MarkCancelled mocks base method.
+func (m *MockBookingSeriesRepository) MarkCancelled(id string, at time.Time) (*models.BookingSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCancelled", id, at)
+	ret0, _ := ret[0].(*models.BookingSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkCancelled indicates an expected call of MarkCancelled.
+func (mr *MockBookingSeriesRepositoryMockRecorder) MarkCancelled(id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCancelled", reflect.TypeOf((*MockBookingSeriesRepository)(nil).MarkCancelled), id, at)
+}
+
+// Update mocks base method.
+func (m *MockBookingSeriesRepository) Update(series *models.BookingSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBookingSeriesRepositoryMockRecorder) Update(series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBookingSeriesRepository)(nil).Update), series)
+}
@@ -7,6 +7,7 @@ package mocks
 
 import (
 	models "glofox-backend/internal/models"
+	repositories "glofox-backend/internal/repositories"
 	reflect "reflect"
 	time "time"
 
@@ -50,6 +51,34 @@ func (mr *MockClassRepositoryMockRecorder) Create(class interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClassRepository)(nil).Create), class)
 }
 
+// Delete mocks base method.
+func (m *MockClassRepository) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockClassRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClassRepository)(nil).Delete), id)
+}
+
+// Find mocks base method.
+func (m *MockClassRepository) Find(query repositories.ClassQuery) []*models.Class {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", query)
+	ret0, _ := ret[0].([]*models.Class)
+	return ret0
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockClassRepositoryMockRecorder) Find(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockClassRepository)(nil).Find), query)
+}
+
 // GetAll mocks base method.
 func (m *MockClassRepository) GetAll() []*models.Class {
 	m.ctrl.T.Helper()
@@ -92,3 +121,43 @@ func (mr *MockClassRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockClassRepository)(nil).GetByID), id)
 }
+
+// ReindexInstructor mocks base method.
+func (m *MockClassRepository) ReindexInstructor(instructorID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReindexInstructor", instructorID)
+}
+
+// ReindexInstructor indicates an expected call of ReindexInstructor.
+func (mr *MockClassRepositoryMockRecorder) ReindexInstructor(instructorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexInstructor", reflect.TypeOf((*MockClassRepository)(nil).ReindexInstructor), instructorID)
+}
+
+// Search mocks base method.
+func (m *MockClassRepository) Search(query string, limit int) []repositories.ClassSearchResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", query, limit)
+	ret0, _ := ret[0].([]repositories.ClassSearchResult)
+	return ret0
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockClassRepositoryMockRecorder) Search(query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockClassRepository)(nil).Search), query, limit)
+}
+
+// Update mocks base method.
+func (m *MockClassRepository) Update(class *models.Class) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockClassRepositoryMockRecorder) Update(class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClassRepository)(nil).Update), class)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repositories/closure.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "glofox-backend/internal/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClosureChecker is a mock of ClosureChecker interface.
+type MockClosureChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockClosureCheckerMockRecorder
+}
+
+// MockClosureCheckerMockRecorder is the mock recorder for MockClosureChecker.
+type MockClosureCheckerMockRecorder struct {
+	mock *MockClosureChecker
+}
+
+// NewMockClosureChecker creates a new mock instance.
+func NewMockClosureChecker(ctrl *gomock.Controller) *MockClosureChecker {
+	mock := &MockClosureChecker{ctrl: ctrl}
+	mock.recorder = &MockClosureCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClosureChecker) EXPECT() *MockClosureCheckerMockRecorder {
+	return m.recorder
+}
+
+// ClosureOn mocks base method.
+func (m *MockClosureChecker) ClosureOn(date time.Time) *models.Closure {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosureOn", date)
+	ret0, _ := ret[0].(*models.Closure)
+	return ret0
+}
+
+// ClosureOn indicates an expected call of ClosureOn.
+func (mr *MockClosureCheckerMockRecorder) ClosureOn(date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosureOn", reflect.TypeOf((*MockClosureChecker)(nil).ClosureOn), date)
+}
+
+// MockClosureRepository is a mock of ClosureRepository interface.
+type MockClosureRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockClosureRepositoryMockRecorder
+}
+
+// MockClosureRepositoryMockRecorder is the mock recorder for MockClosureRepository.
+type MockClosureRepositoryMockRecorder struct {
+	mock *MockClosureRepository
+}
+
+// NewMockClosureRepository creates a new mock instance.
+func NewMockClosureRepository(ctrl *gomock.Controller) *MockClosureRepository {
+	mock := &MockClosureRepository{ctrl: ctrl}
+	mock.recorder = &MockClosureRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClosureRepository) EXPECT() *MockClosureRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClosureOn mocks base method.
+func (m *MockClosureRepository) ClosureOn(date time.Time) *models.Closure {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosureOn", date)
+	ret0, _ := ret[0].(*models.Closure)
+	return ret0
+}
+
+// ClosureOn indicates an expected call of ClosureOn.
+func (mr *MockClosureRepositoryMockRecorder) ClosureOn(date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosureOn", reflect.TypeOf((*MockClosureRepository)(nil).ClosureOn), date)
+}
+
+// Create mocks base method.
+func (m *MockClosureRepository) Create(closure *models.Closure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", closure)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockClosureRepositoryMockRecorder) Create(closure interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClosureRepository)(nil).Create), closure)
+}
+
+// Delete mocks base method.
+func (m *MockClosureRepository) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockClosureRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClosureRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockClosureRepository) GetAll() []*models.Closure {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*models.Closure)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockClosureRepositoryMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockClosureRepository)(nil).GetAll))
+}
+
+// GetByID mocks base method.
+func (m *MockClosureRepository) GetByID(id string) (*models.Closure, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.Closure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockClosureRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockClosureRepository)(nil).GetByID), id)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repositories/instructor.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "glofox-backend/internal/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInstructorLookup is a mock of InstructorLookup interface.
+type MockInstructorLookup struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstructorLookupMockRecorder
+}
+
+// MockInstructorLookupMockRecorder is the mock recorder for MockInstructorLookup.
+type MockInstructorLookupMockRecorder struct {
+	mock *MockInstructorLookup
+}
+
+// NewMockInstructorLookup creates a new mock instance.
+func NewMockInstructorLookup(ctrl *gomock.Controller) *MockInstructorLookup {
+	mock := &MockInstructorLookup{ctrl: ctrl}
+	mock.recorder = &MockInstructorLookupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstructorLookup) EXPECT() *MockInstructorLookupMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockInstructorLookup) GetByID(id string) (*models.Instructor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.Instructor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockInstructorLookupMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockInstructorLookup)(nil).GetByID), id)
+}
+
+// MockInstructorRepository is a mock of InstructorRepository interface.
+type MockInstructorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstructorRepositoryMockRecorder
+}
+
+// MockInstructorRepositoryMockRecorder is the mock recorder for MockInstructorRepository.
+type MockInstructorRepositoryMockRecorder struct {
+	mock *MockInstructorRepository
+}
+
+// NewMockInstructorRepository creates a new mock instance.
+func NewMockInstructorRepository(ctrl *gomock.Controller) *MockInstructorRepository {
+	mock := &MockInstructorRepository{ctrl: ctrl}
+	mock.recorder = &MockInstructorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstructorRepository) EXPECT() *MockInstructorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInstructorRepository) Create(instructor *models.Instructor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", instructor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInstructorRepositoryMockRecorder) Create(instructor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInstructorRepository)(nil).Create), instructor)
+}
+
+// Delete mocks base method.
+func (m *MockInstructorRepository) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInstructorRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInstructorRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockInstructorRepository) GetAll() []*models.Instructor {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*models.Instructor)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockInstructorRepositoryMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockInstructorRepository)(nil).GetAll))
+}
+
+// GetByID mocks base method.
+func (m *MockInstructorRepository) GetByID(id string) (*models.Instructor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.Instructor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockInstructorRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockInstructorRepository)(nil).GetByID), id)
+}
+
+// Update mocks base method.
+func (m *MockInstructorRepository) Update(instructor *models.Instructor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", instructor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInstructorRepositoryMockRecorder) Update(instructor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInstructorRepository)(nil).Update), instructor)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repositories/room.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "glofox-backend/internal/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRoomRepository is a mock of RoomRepository interface.
+type MockRoomRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomRepositoryMockRecorder
+}
+
+// MockRoomRepositoryMockRecorder is the mock recorder for MockRoomRepository.
+type MockRoomRepositoryMockRecorder struct {
+	mock *MockRoomRepository
+}
+
+// NewMockRoomRepository creates a new mock instance.
+func NewMockRoomRepository(ctrl *gomock.Controller) *MockRoomRepository {
+	mock := &MockRoomRepository{ctrl: ctrl}
+	mock.recorder = &MockRoomRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomRepository) EXPECT() *MockRoomRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoomRepository) Create(room *models.Room) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", room)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoomRepositoryMockRecorder) Create(room interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomRepository)(nil).Create), room)
+}
+
+// Delete mocks base method.
+func (m *MockRoomRepository) Delete(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoomRepositoryMockRecorder) Delete(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoomRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockRoomRepository) GetAll() []*models.Room {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*models.Room)
+	return ret0
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRoomRepositoryMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRoomRepository)(nil).GetAll))
+}
+
+// GetByID mocks base method.
+func (m *MockRoomRepository) GetByID(id string) (*models.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", id)
+	ret0, _ := ret[0].(*models.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRoomRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRoomRepository)(nil).GetByID), id)
+}
+
+// Update mocks base method.
+func (m *MockRoomRepository) Update(room *models.Room) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", room)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoomRepositoryMockRecorder) Update(room interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomRepository)(nil).Update), room)
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Availability summarises how full a class is on one date
+type Availability struct {
+	OccurrenceID string    `json:"occurrenceId"`
+	Date         time.Time `json:"date"`
+	Session      Session   `json:"session"`
+	Capacity     int       `json:"capacity"`
+	Booked       int       `json:"booked"`
+	Waitlisted   int       `json:"waitlisted"`
+	Remaining    int       `json:"remaining"`
+}
+
+func NewAvailability(occurrence Occurrence, booked, waitlisted int) Availability {
+	remaining := occurrence.Capacity - booked
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return Availability{
+		OccurrenceID: occurrence.ID,
+		Date:         occurrence.Date,
+		Session:      occurrence.Session,
+		Capacity:     occurrence.Capacity,
+		Booked:       booked,
+		Waitlisted:   waitlisted,
+		Remaining:    remaining,
+	}
+}
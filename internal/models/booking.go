@@ -4,40 +4,86 @@ package models
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Booking struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Date      time.Time `json:"date"`
-	ClassID   string    `json:"classId"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Date    time.Time `json:"date"`
+	ClassID string    `json:"classId"`
+	// OccurrenceID identifies the class occurrence the booking is for, and
+	// MemberID the member holding it. Bookings made before members existed
+	// hold only the name until they are linked.
+	OccurrenceID string    `json:"occurrenceId"`
+	SeriesID     string    `json:"seriesId,omitempty"`
+	MemberID     string    `json:"memberId,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	// Session is the class session the booking is for. A start requested
+	// with an RFC 3339 date is checked against the class when booking.
+	Session Session `json:"session"`
+
+	Status        BookingStatus  `json:"status"`
+	StatusHistory []StatusChange `json:"statusHistory"`
+	Cancellation  *Cancellation  `json:"cancellation,omitempty"`
+	Reschedules   []Reschedule   `json:"reschedules,omitempty"`
+}
+
+// Reschedule records a booking being moved to another class or date
+type Reschedule struct {
+	FromClassID string    `json:"fromClassId"`
+	FromDate    time.Time `json:"fromDate"`
+	ToClassID   string    `json:"toClassId"`
+	ToDate      time.Time `json:"toDate"`
+	At          time.Time `json:"at"`
 }
 
 type BookingInput struct {
-	Name    string `json:"name" binding:"required"`
-	Date    string `json:"date" binding:"required"`
-	ClassID string `json:"classId" binding:"required"`
+	// MemberID books for a member, whose name is used for the booking.
+	// Name alone is still accepted for walk-ins.
+	MemberID string `json:"memberId"`
+	Name     string `json:"name"`
+	// Date takes a YYYY-MM-DD date or the RFC 3339 start of a session
+	Date    string `json:"date"`
+	ClassID string `json:"classId"`
+	// OccurrenceID books an occurrence from GET /classes/{id}/occurrences
+	// instead of a classId and date
+	OccurrenceID string `json:"occurrenceId"`
+	// Waitlist places the member on the waitlist when the class is full
+	Waitlist bool `json:"waitlist"`
 }
 
 func (bi *BookingInput) Validate() error {
-	if bi.Name == "" {
-		return errors.New("name is required")
+	if bi.Name == "" && bi.MemberID == "" {
+		return errors.New("name or memberId is required")
+	}
+
+	if bi.OccurrenceID != "" {
+		_, _, err := ParseOccurrenceID(bi.OccurrenceID)
+		return err
 	}
 
 	if bi.ClassID == "" {
 		return errors.New("classId is required")
 	}
 
-	_, err := time.Parse("2006-01-02", bi.Date)
-	if err != nil {
-		return errors.New("invalid date format. Use YYYY-MM-DD")
+	_, _, err := ParseSessionRequest(bi.Date)
+	return err
+}
+
+// sessionRequest returns the class, date and requested session start of the
+// booking input
+func (bi *BookingInput) sessionRequest() (string, time.Time, time.Time) {
+	if bi.OccurrenceID != "" {
+		classID, date, _ := ParseOccurrenceID(bi.OccurrenceID)
+		return classID, date, time.Time{}
 	}
 
-	return nil
+	date, start, _ := ParseSessionRequest(bi.Date)
+	return bi.ClassID, date, start
 }
 
 func NewBooking(input BookingInput) (*Booking, error) {
@@ -45,13 +91,128 @@ func NewBooking(input BookingInput) (*Booking, error) {
 		return nil, err
 	}
 
-	date, _ := time.Parse("2006-01-02", input.Date)
+	classID, date, start := input.sessionRequest()
+	now := time.Now()
 
 	return &Booking{
-		ID:        uuid.New().String(),
-		Name:      input.Name,
-		Date:      date,
-		ClassID:   input.ClassID,
-		CreatedAt: time.Now(),
+		ID:            uuid.New().String(),
+		Name:          input.Name,
+		MemberID:      input.MemberID,
+		Date:          date,
+		ClassID:       classID,
+		OccurrenceID:  OccurrenceID(classID, date),
+		CreatedAt:     now,
+		Session:       Session{Start: start},
+		Status:        BookingStatusPending,
+		StatusHistory: []StatusChange{{Status: BookingStatusPending, At: now}},
 	}, nil
 }
+
+// ParseSessionRequest parses a YYYY-MM-DD date or the RFC 3339 start of a
+// session into the date and the requested session start, which is zero when
+// no time of day was given
+func ParseSessionRequest(value string) (time.Time, time.Time, error) {
+	at, hasTime, err := parseDateOrDateTime(value)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid date format. Use YYYY-MM-DD or RFC 3339")
+	}
+	if !hasTime {
+		return at, time.Time{}, nil
+	}
+	return DateOf(at), at, nil
+}
+
+// SameMember reports whether two bookings are held by the same member.
+// Bookings linked to members are compared by member ID. A booking holding
+// only a name matches any booking under that name, as a linked booking holds
+// the name of its member.
+func (b *Booking) SameMember(other *Booking) bool {
+	if b.MemberID != "" && other.MemberID != "" {
+		return b.MemberID == other.MemberID
+	}
+	return NormalizeName(b.Name) == NormalizeName(other.Name)
+}
+
+// NormalizeName folds case and surrounding or repeated whitespace so names can
+// be compared
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// Clone returns a copy of the booking that shares no state with it, so it can
+// be read while the original keeps changing
+func (b *Booking) Clone() *Booking {
+	cloned := *b
+	cloned.StatusHistory = append([]StatusChange(nil), b.StatusHistory...)
+	cloned.Reschedules = append([]Reschedule(nil), b.Reschedules...)
+	if b.Cancellation != nil {
+		cancellation := *b.Cancellation
+		cloned.Cancellation = &cancellation
+	}
+	return &cloned
+}
+
+func (b *Booking) IsCancelled() bool {
+	return b.Status == BookingStatusCancelled
+}
+
+type BookingStatusInput struct {
+	Status string `json:"status" binding:"required"`
+}
+
+var ErrCheckInNotOpen = errors.New("check-in is not open until the day of the class")
+
+// CanCheckIn reports whether the member can be marked as attended at the given
+// time. Check-in opens on the day of the class.
+func (b *Booking) CanCheckIn(now time.Time) error {
+	if !b.Status.CanTransitionTo(BookingStatusAttended) {
+		return &InvalidTransitionError{From: b.Status, To: BookingStatusAttended}
+	}
+
+	if now.Before(DateOf(b.Date)) {
+		return ErrCheckInNotOpen
+	}
+	return nil
+}
+
+type CheckInInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type RosterCheckInInput struct {
+	ClassID string `json:"classId" binding:"required"`
+	Date    string `json:"date" binding:"required"`
+	// BookingIDs limits the check-in to these bookings, all bookings on the
+	// roster are checked in when empty
+	BookingIDs []string `json:"bookingIds"`
+}
+
+func (ri *RosterCheckInInput) Validate() error {
+	if ri.ClassID == "" {
+		return errors.New("classId is required")
+	}
+
+	_, err := ParseDate(ri.Date)
+	if err != nil {
+		return errors.New("invalid date format. Use YYYY-MM-DD")
+	}
+
+	return nil
+}
+
+var (
+	ErrBookingNotReschedulable = errors.New("only confirmed bookings can be rescheduled")
+	ErrRescheduleToSameSlot    = errors.New("booking is already on the requested class and date")
+)
+
+type RescheduleInput struct {
+	// Date takes a YYYY-MM-DD date or the RFC 3339 start of a session
+	Date string `json:"date" binding:"required"`
+	// ClassID moves the booking to another class, the current class is kept when empty
+	ClassID string `json:"classId"`
+}
+
+func (ri *RescheduleInput) Validate() error {
+	_, _, err := ParseSessionRequest(ri.Date)
+	return err
+}
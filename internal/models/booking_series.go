@@ -0,0 +1,215 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxSeriesWeeks bounds how far ahead a series can book
+const maxSeriesWeeks = 52
+
+const (
+	SeriesFailureFull       = "full"
+	SeriesFailureOutOfRange = "out_of_range"
+	SeriesFailureDuplicate  = "duplicate"
+	SeriesFailureClosed     = "closed"
+	SeriesFailureOther      = "error"
+)
+
+// BookingSeries is a set of bookings for a class created from a weekly
+// recurrence pattern, e.g. every Tuesday for the next 8 weeks
+type BookingSeries struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	MemberID      string          `json:"memberId,omitempty"`
+	ClassID       string          `json:"classId"`
+	Weekdays      []string        `json:"weekdays"`
+	IntervalWeeks int             `json:"intervalWeeks"`
+	StartDate     time.Time       `json:"startDate"`
+	EndDate       time.Time       `json:"endDate"`
+	BookingIDs    []string        `json:"bookingIds"`
+	Failures      []SeriesFailure `json:"failures"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CancelledAt   *time.Time      `json:"cancelledAt,omitempty"`
+}
+
+// SeriesFailure reports a date of a series that could not be booked or cancelled
+type SeriesFailure struct {
+	Date      time.Time `json:"date"`
+	BookingID string    `json:"bookingId,omitempty"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+}
+
+type BookingSeriesInput struct {
+	// MemberID books the series for a member, whose name is used for the
+	// bookings. Name alone is still accepted.
+	MemberID  string   `json:"memberId"`
+	Name      string   `json:"name"`
+	ClassID   string   `json:"classId" binding:"required"`
+	StartDate string   `json:"startDate" binding:"required"`
+	Weekdays  []string `json:"weekdays" binding:"required"`
+	// Weeks is how many weeks the series runs for, from the start date
+	Weeks int `json:"weeks"`
+	// EndDate is the last date of the series, used instead of weeks
+	EndDate string `json:"endDate"`
+	// IntervalWeeks repeats the series every N weeks, defaults to 1
+	IntervalWeeks int `json:"intervalWeeks"`
+}
+
+func (si *BookingSeriesInput) Validate() error {
+	if si.Name == "" && si.MemberID == "" {
+		return errors.New("name or memberId is required")
+	}
+
+	if si.ClassID == "" {
+		return errors.New("classId is required")
+	}
+
+	startDate, err := ParseDate(si.StartDate)
+	if err != nil {
+		return errors.New("invalid startDate format. Use YYYY-MM-DD")
+	}
+
+	if len(si.Weekdays) == 0 {
+		return errors.New("at least one weekday is required")
+	}
+	for _, day := range si.Weekdays {
+		if _, err := ParseWeekday(day); err != nil {
+			return err
+		}
+	}
+
+	if si.IntervalWeeks < 0 {
+		return errors.New("intervalWeeks must be at least 1")
+	}
+
+	if (si.Weeks == 0) == (si.EndDate == "") {
+		return errors.New("either weeks or endDate is required")
+	}
+	if si.Weeks < 0 || si.Weeks > maxSeriesWeeks {
+		return fmt.Errorf("weeks must be between 1 and %d", maxSeriesWeeks)
+	}
+	if si.EndDate != "" {
+		endDate, err := ParseDate(si.EndDate)
+		if err != nil {
+			return errors.New("invalid endDate format. Use YYYY-MM-DD")
+		}
+		if endDate.Before(startDate) {
+			return errors.New("endDate must be after startDate")
+		}
+		if endDate.After(startDate.AddDate(0, 0, 7*maxSeriesWeeks)) {
+			return fmt.Errorf("a series cannot run for more than %d weeks", maxSeriesWeeks)
+		}
+	}
+
+	return nil
+}
+
+func NewBookingSeries(input BookingSeriesInput) (*BookingSeries, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	startDate, _ := ParseDate(input.StartDate)
+	endDate := startDate.AddDate(0, 0, 7*input.Weeks-1)
+	if input.EndDate != "" {
+		endDate, _ = ParseDate(input.EndDate)
+	}
+
+	interval := input.IntervalWeeks
+	if interval == 0 {
+		interval = 1
+	}
+
+	series := &BookingSeries{
+		ID:            uuid.New().String(),
+		Name:          input.Name,
+		MemberID:      input.MemberID,
+		ClassID:       input.ClassID,
+		IntervalWeeks: interval,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		BookingIDs:    make([]string, 0),
+		Failures:      make([]SeriesFailure, 0),
+		CreatedAt:     time.Now(),
+	}
+	for _, day := range input.Weekdays {
+		weekday, _ := ParseWeekday(day)
+		series.Weekdays = append(series.Weekdays, strings.ToLower(weekday.String()))
+	}
+
+	return series, nil
+}
+
+// Clone returns a copy of the series that shares no state with it
+func (s *BookingSeries) Clone() *BookingSeries {
+	cloned := *s
+	cloned.Weekdays = append([]string(nil), s.Weekdays...)
+	cloned.BookingIDs = append(make([]string, 0, len(s.BookingIDs)), s.BookingIDs...)
+	cloned.Failures = append(make([]SeriesFailure, 0, len(s.Failures)), s.Failures...)
+	if s.CancelledAt != nil {
+		cancelledAt := *s.CancelledAt
+		cloned.CancelledAt = &cancelledAt
+	}
+	return &cloned
+}
+
+// Dates expands the recurrence pattern into the dates of the series
+func (s *BookingSeries) Dates() []time.Time {
+	days := make(map[time.Weekday]bool, len(s.Weekdays))
+	for _, day := range s.Weekdays {
+		if weekday, err := ParseWeekday(day); err == nil {
+			days[weekday] = true
+		}
+	}
+
+	dates := make([]time.Time, 0)
+	for date := s.StartDate; !date.After(s.EndDate); date = date.AddDate(0, 0, 1) {
+		week := daysBetween(s.StartDate, date) / 7
+		if week%s.IntervalWeeks == 0 && days[date.Weekday()] {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// NewBooking builds the booking for one date of the series
+func (s *BookingSeries) NewBooking(date time.Time) (*Booking, error) {
+	booking, err := NewBooking(BookingInput{
+		MemberID: s.MemberID,
+		Name:     s.Name,
+		Date:     date.Format("2006-01-02"),
+		ClassID:  s.ClassID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	booking.SeriesID = s.ID
+	return booking, nil
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"su": time.Sunday, "sun": time.Sunday, "sunday": time.Sunday,
+	"mo": time.Monday, "mon": time.Monday, "monday": time.Monday,
+	"tu": time.Tuesday, "tue": time.Tuesday, "tuesday": time.Tuesday,
+	"we": time.Wednesday, "wed": time.Wednesday, "wednesday": time.Wednesday,
+	"th": time.Thursday, "thu": time.Thursday, "thursday": time.Thursday,
+	"fr": time.Friday, "fri": time.Friday, "friday": time.Friday,
+	"sa": time.Saturday, "sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseWeekday accepts full (monday), short (mon) and two letter (MO) weekday
+// names in any case
+func ParseWeekday(value string) (time.Weekday, error) {
+	weekday, exists := weekdayNames[strings.ToLower(strings.TrimSpace(value))]
+	if !exists {
+		return 0, fmt.Errorf("invalid weekday %q", value)
+	}
+	return weekday, nil
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingSeries_Dates(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		input    BookingSeriesInput
+		want     []string
+	}{
+		{
+			name:     "weekly on two days",
+			location: time.UTC,
+			input:    BookingSeriesInput{StartDate: "2030-03-04", Weekdays: []string{"mo", "we"}, Weeks: 2},
+			want:     []string{"2030-03-04", "2030-03-06", "2030-03-11", "2030-03-13"},
+		},
+		{
+			// Clocks go forward on 2030-03-10, making that week an hour short
+			name:     "every other week across a DST change",
+			location: newYork,
+			input:    BookingSeriesInput{StartDate: "2030-03-04", Weekdays: []string{"monday"}, EndDate: "2030-04-15", IntervalWeeks: 2},
+			want:     []string{"2030-03-04", "2030-03-18", "2030-04-01", "2030-04-15"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetStudioLocation(tt.location)
+			defer SetStudioLocation(time.UTC)
+
+			tt.input.Name = "John Doe"
+			tt.input.ClassID = "class-1"
+			series, err := NewBookingSeries(tt.input)
+			require.NoError(t, err)
+
+			dates := make([]string, 0)
+			for _, date := range series.Dates() {
+				dates = append(dates, date.Format(dateLayout))
+			}
+			assert.Equal(t, tt.want, dates)
+		})
+	}
+}
+
+func TestBookingSeries_Clone(t *testing.T) {
+	cancelledAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	series := &BookingSeries{
+		Weekdays:    []string{"monday"},
+		BookingIDs:  []string{"booking-1"},
+		Failures:    []SeriesFailure{{Reason: SeriesFailureFull}},
+		CancelledAt: &cancelledAt,
+	}
+
+	cloned := series.Clone()
+	cloned.Weekdays[0] = "friday"
+	cloned.BookingIDs[0] = "booking-2"
+	cloned.Failures[0].Reason = SeriesFailureClosed
+	*cloned.CancelledAt = cancelledAt.AddDate(0, 0, 1)
+
+	assert.Equal(t, "monday", series.Weekdays[0])
+	assert.Equal(t, "booking-1", series.BookingIDs[0])
+	assert.Equal(t, SeriesFailureFull, series.Failures[0].Reason)
+	assert.Equal(t, cancelledAt, *series.CancelledAt)
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type BookingStatus string
+
+const (
+	BookingStatusPending    BookingStatus = "pending"
+	BookingStatusConfirmed  BookingStatus = "confirmed"
+	BookingStatusWaitlisted BookingStatus = "waitlisted"
+	BookingStatusCancelled  BookingStatus = "cancelled"
+	BookingStatusAttended   BookingStatus = "attended"
+	BookingStatusNoShow     BookingStatus = "no-show"
+)
+
+// bookingTransitions lists the statuses a booking may move to from each status
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending:    {BookingStatusConfirmed, BookingStatusWaitlisted, BookingStatusCancelled},
+	BookingStatusWaitlisted: {BookingStatusConfirmed, BookingStatusCancelled},
+	BookingStatusConfirmed:  {BookingStatusAttended, BookingStatusNoShow, BookingStatusWaitlisted, BookingStatusCancelled},
+	BookingStatusNoShow:     {BookingStatusAttended},
+	BookingStatusAttended:   {},
+	BookingStatusCancelled:  {},
+}
+
+// StatusChange records when a booking entered a status
+type StatusChange struct {
+	Status BookingStatus `json:"status"`
+	At     time.Time     `json:"at"`
+}
+
+// InvalidTransitionError is returned when a booking cannot move between two statuses
+type InvalidTransitionError struct {
+	From BookingStatus
+	To   BookingStatus
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("booking cannot change from %s to %s", e.From, e.To)
+}
+
+func ParseBookingStatus(value string) (BookingStatus, error) {
+	status := BookingStatus(value)
+	if _, exists := bookingTransitions[status]; !exists {
+		return "", fmt.Errorf("invalid booking status %q", value)
+	}
+	return status, nil
+}
+
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive reports whether a booking in this status takes up a spot in the class
+func (s BookingStatus) IsActive() bool {
+	switch s {
+	case BookingStatusPending, BookingStatusConfirmed, BookingStatusAttended, BookingStatusNoShow:
+		return true
+	}
+	return false
+}
+
+// TransitionTo moves the booking to the next status and records when it happened
+func (b *Booking) TransitionTo(next BookingStatus, at time.Time) error {
+	if !b.Status.CanTransitionTo(next) {
+		return &InvalidTransitionError{From: b.Status, To: next}
+	}
+
+	b.Status = next
+	b.StatusHistory = append(b.StatusHistory, StatusChange{Status: next, At: at})
+	return nil
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBookingAlreadyCancelled    = errors.New("booking is already cancelled")
+	ErrLateCancellationNotAllowed = errors.New("booking can no longer be cancelled, the free cancellation window has passed")
+)
+
+// Cancellation records who cancelled a booking, when and why
+type Cancellation struct {
+	CancelledBy string    `json:"cancelledBy,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+	Late        bool      `json:"late"`
+	CancelledAt time.Time `json:"cancelledAt"`
+}
+
+// CancellationPolicy describes the studio rules for cancelling bookings
+type CancellationPolicy struct {
+	// FreeCancellationWindow is how long before the class starts a booking
+	// stops being freely cancellable
+	FreeCancellationWindow time.Duration
+	// AllowLateCancellation lets members cancel inside the window, in which
+	// case the cancellation is flagged as late
+	AllowLateCancellation bool
+}
+
+func DefaultCancellationPolicy() CancellationPolicy {
+	return CancellationPolicy{
+		FreeCancellationWindow: 24 * time.Hour,
+		AllowLateCancellation:  true,
+	}
+}
+
+// IsLate reports whether cancelling the booking at the given time falls inside
+// the free cancellation window, counted back from the session start or from
+// the start of the day when the booking has no session time
+func (p CancellationPolicy) IsLate(booking *Booking, now time.Time) bool {
+	start := booking.Date
+	if !booking.Session.Start.IsZero() {
+		start = booking.Session.Start
+	}
+	deadline := start.Add(-p.FreeCancellationWindow)
+	return !now.Before(deadline)
+}
+
+// Apply builds the cancellation for a booking, rejecting it if the policy does
+// not allow late cancellations
+func (p CancellationPolicy) Apply(booking *Booking, cancelledBy, reason string, now time.Time) (*Cancellation, error) {
+	if booking.IsCancelled() {
+		return nil, ErrBookingAlreadyCancelled
+	}
+	if !booking.Status.CanTransitionTo(BookingStatusCancelled) {
+		return nil, &InvalidTransitionError{From: booking.Status, To: BookingStatusCancelled}
+	}
+
+	late := p.IsLate(booking, now)
+	if late && !p.AllowLateCancellation {
+		return nil, ErrLateCancellationNotAllowed
+	}
+
+	return &Cancellation{
+		CancelledBy: cancelledBy,
+		Reason:      reason,
+		Late:        late,
+		CancelledAt: now,
+	}, nil
+}
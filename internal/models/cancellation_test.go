@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationPolicy_IsLate(t *testing.T) {
+	policy := CancellationPolicy{FreeCancellationWindow: 24 * time.Hour}
+	date := time.Date(2030, 3, 4, 0, 0, 0, 0, time.UTC)
+	evening := Session{Start: date.Add(18 * time.Hour), End: date.Add(19 * time.Hour)}
+
+	tests := []struct {
+		name    string
+		session Session
+		now     time.Time
+		late    bool
+	}{
+		{"all day, before the window", Session{}, date.Add(-25 * time.Hour), false},
+		{"all day, inside the window", Session{}, date.Add(-23 * time.Hour), true},
+		{"session, before the window", evening, date.Add(-7 * time.Hour), false},
+		{"session, at the deadline", evening, date.Add(-6 * time.Hour), true},
+		{"session, on the day", evening, date.Add(12 * time.Hour), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := &Booking{Date: date, Session: tt.session}
+			assert.Equal(t, tt.late, policy.IsLate(booking, tt.now))
+		})
+	}
+}
@@ -7,20 +7,75 @@ import (
 	"github.com/google/uuid"
 )
 
+// maxClassDatesSpan bounds the date range a class schedule is expanded over
+const maxClassDatesSpan = 366
+
 type Class struct {
-	ID        string    `json:"id"`
-	ClassName string    `json:"className"`
-	StartDate time.Time `json:"startDate"`
-	EndDate   time.Time `json:"endDate"`
-	Capacity  int       `json:"capacity"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID          string `json:"id"`
+	ClassName   string `json:"className"`
+	Description string `json:"description,omitempty"`
+	// Category and Tags are stored lowercased
+	Category   string          `json:"category,omitempty"`
+	Tags       []string        `json:"tags,omitempty"`
+	Difficulty ClassDifficulty `json:"difficulty,omitempty"`
+	StartDate  time.Time       `json:"startDate"`
+	EndDate    time.Time       `json:"endDate"`
+	// StartTime is the HH:MM time the daily session starts, the class runs
+	// all day when empty
+	StartTime       string `json:"startTime,omitempty"`
+	DurationMinutes int    `json:"durationMinutes,omitempty"`
+	EndTime         string `json:"endTime,omitempty"`
+	// Recurrence is an RFC 5545 RRULE limiting the dates between StartDate
+	// and EndDate the class runs on, it runs every day when empty
+	Recurrence string      `json:"recurrence,omitempty"`
+	ExDates    []time.Time `json:"exDates,omitempty"`
+	// Overrides holds changes to single occurrences, keyed by YYYY-MM-DD date
+	Overrides    map[string]ClassOverride `json:"overrides,omitempty"`
+	InstructorID string                   `json:"instructorId,omitempty"`
+	RoomID       string                   `json:"roomId,omitempty"`
+	Capacity     int                      `json:"capacity"`
+	// MinAttendance is the fewest bookings an occurrence needs to run. At
+	// CutoffMinutes before its start an occurrence with fewer bookings is
+	// cancelled.
+	MinAttendance int        `json:"minAttendance,omitempty"`
+	CutoffMinutes int        `json:"cutoffMinutes,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+	ArchivedAt    *time.Time `json:"archivedAt,omitempty"`
 }
 
 type ClassInput struct {
-	ClassName string `json:"className" binding:"required"`
+	ClassName   string `json:"className" binding:"required"`
+	Description string `json:"description"`
+	// Category is the kind of class, such as yoga, hiit or spin, and Tags
+	// are free-form labels
+	Category string   `json:"category"`
+	Tags     []string `json:"tags"`
+	// Difficulty is all-levels, beginner, intermediate or advanced
+	Difficulty string `json:"difficulty"`
+	// StartDate and EndDate take YYYY-MM-DD dates or RFC 3339 datetimes
 	StartDate string `json:"startDate" binding:"required"`
 	EndDate   string `json:"endDate" binding:"required"`
-	Capacity  int    `json:"capacity" binding:"required,min=1"`
+	// StartTime is the HH:MM time the daily session starts, with either
+	// EndTime or DurationMinutes for its length
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	DurationMinutes int    `json:"durationMinutes"`
+	// Recurrence is an RFC 5545 RRULE such as FREQ=WEEKLY;BYDAY=MO,WE,FR and
+	// ExDates lists YYYY-MM-DD dates it skips
+	Recurrence string   `json:"recurrence"`
+	ExDates    []string `json:"exDates"`
+	// InstructorID assigns an instructor to every session of the class
+	InstructorID string `json:"instructorId"`
+	// RoomID is the room the class takes place in, its capacity caps the
+	// class capacity
+	RoomID   string `json:"roomId"`
+	Capacity int    `json:"capacity" binding:"required,min=1"`
+	// MinAttendance cancels occurrences with fewer bookings CutoffMinutes
+	// before they start, 0 runs every occurrence. CutoffMinutes is required
+	// with a minimum attendance.
+	MinAttendance int `json:"minAttendance"`
+	CutoffMinutes int `json:"cutoffMinutes"`
 }
 
 func (ci *ClassInput) Validate() error {
@@ -28,22 +83,30 @@ func (ci *ClassInput) Validate() error {
 		return errors.New("className is required")
 	}
 
-	startDate, err := time.Parse("2006-01-02", ci.StartDate)
-	if err != nil {
-		return errors.New("invalid startDate format. Use YYYY-MM-DD")
+	if _, err := ci.schedule(); err != nil {
+		return err
 	}
 
-	endDate, err := time.Parse("2006-01-02", ci.EndDate)
-	if err != nil {
-		return errors.New("invalid endDate format. Use YYYY-MM-DD")
+	if ci.Capacity < 1 {
+		return errors.New("capacity must be at least 1")
 	}
 
-	if endDate.Before(startDate) {
-		return errors.New("endDate must be after startDate")
+	if _, err := ParseClassDifficulty(ci.Difficulty); err != nil {
+		return err
 	}
 
-	if ci.Capacity < 1 {
-		return errors.New("capacity must be at least 1")
+	if ci.MinAttendance < 0 || ci.MinAttendance > ci.Capacity {
+		return errors.New("minAttendance must be between 0 and the capacity")
+	}
+
+	if ci.CutoffMinutes < 0 {
+		return errors.New("cutoffMinutes cannot be negative")
+	}
+
+	// Occurrences are checked between the cutoff and their start, so a
+	// minimum attendance needs a cutoff before the start
+	if ci.MinAttendance > 0 && ci.CutoffMinutes == 0 {
+		return errors.New("cutoffMinutes must be at least 1 when minAttendance is set")
 	}
 
 	return nil
@@ -54,24 +117,116 @@ func NewClass(input ClassInput) (*Class, error) {
 		return nil, err
 	}
 
-	startDate, _ := time.Parse("2006-01-02", input.StartDate)
-	endDate, _ := time.Parse("2006-01-02", input.EndDate)
+	difficulty, _ := ParseClassDifficulty(input.Difficulty)
+	class := &Class{
+		ID:            uuid.New().String(),
+		ClassName:     input.ClassName,
+		Description:   input.Description,
+		Category:      NormalizeLabel(input.Category),
+		Tags:          normalizeTags(input.Tags),
+		Difficulty:    difficulty,
+		InstructorID:  input.InstructorID,
+		RoomID:        input.RoomID,
+		Capacity:      input.Capacity,
+		MinAttendance: input.MinAttendance,
+		CutoffMinutes: input.CutoffMinutes,
+		CreatedAt:     time.Now(),
+	}
+	schedule, _ := input.schedule()
+	schedule.apply(class)
+	return class, nil
+}
+
+func (c *Class) IsArchived() bool {
+	return c.ArchivedAt != nil
+}
+
+// HasMinAttendance reports whether under-subscribed occurrences of the class
+// are cancelled
+func (c *Class) HasMinAttendance() bool {
+	return c.MinAttendance > 0
+}
 
-	return &Class{
-		ID:        uuid.New().String(),
-		ClassName: input.ClassName,
-		StartDate: startDate,
-		EndDate:   endDate,
-		Capacity:  input.Capacity,
-		CreatedAt: time.Now(),
-	}, nil
+// AttendanceCutoffOn returns when the occurrence on the given date is checked
+// against the minimum attendance
+func (c *Class) AttendanceCutoffOn(date time.Time) time.Time {
+	return c.SessionOn(date).Start.Add(-time.Duration(c.CutoffMinutes) * time.Minute)
 }
 
+// IsDateInRange reports whether the class runs on the given date: the date is
+// scheduled and the occurrence on it has not been cancelled
 func (c *Class) IsDateInRange(date time.Time) bool {
+	return c.IsScheduledOn(date) && !c.IsCancelledOn(date)
+}
+
+// IsScheduledOn reports whether the schedule of the class includes the given
+// date: the date is between the start and end dates, matches the recurrence
+// rule and is not an excluded date
+func (c *Class) IsScheduledOn(date time.Time) bool {
+
+	date = DateOf(date)
+	startDate := DateOf(c.StartDate)
+	endDate := DateOf(c.EndDate)
+
+	if date.Before(startDate) || date.After(endDate) {
+		return false
+	}
 
-	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
-	startDate := time.Date(c.StartDate.Year(), c.StartDate.Month(), c.StartDate.Day(), 0, 0, 0, 0, time.UTC)
-	endDate := time.Date(c.EndDate.Year(), c.EndDate.Month(), c.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	for _, exDate := range c.ExDates {
+		if date.Equal(DateOf(exDate)) {
+			return false
+		}
+	}
 
-	return (date.Equal(startDate) || date.After(startDate)) && (date.Equal(endDate) || date.Before(endDate))
+	if c.Recurrence == "" {
+		return true
+	}
+	rule, err := ParseRecurrenceRule(c.Recurrence)
+	return err == nil && rule.Occurs(startDate, date)
+}
+
+// Dates returns the dates between from and to (inclusive) the class runs on.
+// Empty bounds default to the class start and end dates, limited to 366 days
+// from the given bound, or from today when neither is given. A range given in
+// full cannot span more than 366 days.
+func (c *Class) Dates(from, to time.Time) ([]time.Time, error) {
+	fromGiven, toGiven := !from.IsZero(), !to.IsZero()
+	if !fromGiven || from.Before(c.StartDate) {
+		from = c.StartDate
+	}
+	if !toGiven || to.After(c.EndDate) {
+		to = c.EndDate
+	}
+
+	from = DateOf(from)
+	to = DateOf(to)
+	if daysBetween(from, to) > maxClassDatesSpan {
+		switch {
+		case fromGiven && toGiven:
+			return nil, errors.New("date range cannot span more than 366 days")
+		case fromGiven:
+			to = from.AddDate(0, 0, maxClassDatesSpan)
+		case toGiven:
+			from = to.AddDate(0, 0, -maxClassDatesSpan)
+		default:
+			// A long class is listed from today, or for its last year once
+			// it is over
+			start := DateOf(time.Now())
+			if last := to.AddDate(0, 0, -maxClassDatesSpan); last.Before(start) {
+				start = last
+			}
+			if start.After(from) {
+				from = start
+			}
+			to = from.AddDate(0, 0, maxClassDatesSpan)
+		}
+	}
+
+	dates := make([]time.Time, 0)
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		if c.IsDateInRange(date) {
+			dates = append(dates, date)
+		}
+	}
+	return dates, nil
 }
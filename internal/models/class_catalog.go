@@ -0,0 +1,67 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClassDifficulty is how demanding a class is
+type ClassDifficulty string
+
+const (
+	ClassDifficultyAllLevels    ClassDifficulty = "all-levels"
+	ClassDifficultyBeginner     ClassDifficulty = "beginner"
+	ClassDifficultyIntermediate ClassDifficulty = "intermediate"
+	ClassDifficultyAdvanced     ClassDifficulty = "advanced"
+)
+
+// ParseClassDifficulty reads a difficulty level, case-insensitively. An empty
+// value leaves the difficulty unset.
+func ParseClassDifficulty(value string) (ClassDifficulty, error) {
+	switch difficulty := ClassDifficulty(strings.ToLower(strings.TrimSpace(value))); difficulty {
+	case "", ClassDifficultyAllLevels, ClassDifficultyBeginner, ClassDifficultyIntermediate, ClassDifficultyAdvanced:
+		return difficulty, nil
+	}
+	return "", fmt.Errorf("invalid difficulty %q, use all-levels, beginner, intermediate or advanced", value)
+}
+
+// NormalizeLabel lowercases and trims a category or tag so filters match
+// regardless of how it was typed
+func NormalizeLabel(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// normalizeTags normalizes tags, dropping empty and repeated ones
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeLabel(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
+
+// HasTags reports whether the class is labelled with every given tag
+func (c *Class) HasTags(tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, own := range c.Tags {
+			if own == NormalizeLabel(tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrOccurrenceCancelled = errors.New("class is cancelled on the requested date")
+
+// ClassOverride changes a single occurrence of a class without touching the
+// rest of its schedule
+type ClassOverride struct {
+	Date      time.Time `json:"date"`
+	Cancelled bool      `json:"cancelled,omitempty"`
+	// Capacity replaces the class capacity on this date when set
+	Capacity int `json:"capacity,omitempty"`
+	// StartTime and DurationMinutes replace the session time on this date
+	// when set
+	StartTime       string    `json:"startTime,omitempty"`
+	DurationMinutes int       `json:"durationMinutes,omitempty"`
+	InstructorID    string    `json:"instructorId,omitempty"`
+	Reason          string    `json:"reason,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type ClassOverrideInput struct {
+	// Cancelled cancels the occurrence and every booking on it
+	Cancelled bool `json:"cancelled"`
+	// Capacity replaces the class capacity on this date, 0 keeps it
+	Capacity int `json:"capacity"`
+	// StartTime moves the session, with EndTime or DurationMinutes to change
+	// its length
+	StartTime       string `json:"startTime"`
+	EndTime         string `json:"endTime"`
+	DurationMinutes int    `json:"durationMinutes"`
+	// InstructorID is the substitute teaching this occurrence
+	InstructorID string `json:"instructorId"`
+	Reason       string `json:"reason"`
+}
+
+func (oi *ClassOverrideInput) Validate() error {
+	if oi.Capacity < 0 {
+		return errors.New("capacity cannot be negative")
+	}
+
+	_, _, err := sessionTimes(oi.StartTime, oi.EndTime, oi.DurationMinutes, false)
+	return err
+}
+
+// NewClassOverride builds the override of a class occurrence on the given date
+func NewClassOverride(date time.Time, input ClassOverrideInput) (*ClassOverride, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	startTime, durationMinutes, _ := sessionTimes(input.StartTime, input.EndTime, input.DurationMinutes, false)
+	return &ClassOverride{
+		Date:            DateOf(date),
+		Cancelled:       input.Cancelled,
+		Capacity:        input.Capacity,
+		StartTime:       startTime,
+		DurationMinutes: durationMinutes,
+		InstructorID:    input.InstructorID,
+		Reason:          input.Reason,
+		UpdatedAt:       time.Now(),
+	}, nil
+}
+
+// OverrideOn returns the override of the occurrence on the given date, if any
+func (c *Class) OverrideOn(date time.Time) (ClassOverride, bool) {
+	override, exists := c.Overrides[DateOf(date).Format(dateLayout)]
+	return override, exists
+}
+
+// IsCancelledOn reports whether the occurrence on the given date is cancelled
+func (c *Class) IsCancelledOn(date time.Time) bool {
+	override, exists := c.OverrideOn(date)
+	return exists && override.Cancelled
+}
+
+// CapacityOn returns the capacity of the class on the given date
+func (c *Class) CapacityOn(date time.Time) int {
+	if override, exists := c.OverrideOn(date); exists && override.Capacity > 0 {
+		return override.Capacity
+	}
+	return c.Capacity
+}
+
+// WithOverride returns a copy of the class with the override of the given date
+// replaced, or removed when override is nil
+func (c *Class) WithOverride(date time.Time, override *ClassOverride) *Class {
+	updated := *c
+	updated.Overrides = make(map[string]ClassOverride, len(c.Overrides)+1)
+	for key, existing := range c.Overrides {
+		updated.Overrides[key] = existing
+	}
+
+	key := DateOf(date).Format(dateLayout)
+	if override == nil {
+		delete(updated.Overrides, key)
+	} else {
+		updated.Overrides[key] = *override
+	}
+	if len(updated.Overrides) == 0 {
+		updated.Overrides = nil
+	}
+	return &updated
+}
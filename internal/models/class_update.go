@@ -0,0 +1,233 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ConflictOutOfRange   = "out_of_range"
+	ConflictOverCapacity = "over_capacity"
+)
+
+// ClassPatchInput holds the class fields to change in a partial update
+type ClassPatchInput struct {
+	ClassName       *string   `json:"className,omitempty"`
+	Description     *string   `json:"description,omitempty"`
+	Category        *string   `json:"category,omitempty"`
+	Tags            *[]string `json:"tags,omitempty"`
+	Difficulty      *string   `json:"difficulty,omitempty"`
+	StartDate       *string   `json:"startDate,omitempty"`
+	EndDate         *string   `json:"endDate,omitempty"`
+	StartTime       *string   `json:"startTime,omitempty"`
+	EndTime         *string   `json:"endTime,omitempty"`
+	DurationMinutes *int      `json:"durationMinutes,omitempty"`
+	Recurrence      *string   `json:"recurrence,omitempty"`
+	ExDates         *[]string `json:"exDates,omitempty"`
+	InstructorID    *string   `json:"instructorId,omitempty"`
+	RoomID          *string   `json:"roomId,omitempty"`
+	Capacity        *int      `json:"capacity,omitempty"`
+	MinAttendance   *int      `json:"minAttendance,omitempty"`
+	CutoffMinutes   *int      `json:"cutoffMinutes,omitempty"`
+}
+
+// Apply returns the input with the patched fields replaced
+func (pi *ClassPatchInput) Apply(input ClassInput) ClassInput {
+	if pi.ClassName != nil {
+		input.ClassName = *pi.ClassName
+	}
+	if pi.Description != nil {
+		input.Description = *pi.Description
+	}
+	if pi.Category != nil {
+		input.Category = *pi.Category
+	}
+	if pi.Tags != nil {
+		input.Tags = *pi.Tags
+	}
+	if pi.Difficulty != nil {
+		input.Difficulty = *pi.Difficulty
+	}
+	// An RFC 3339 date carries a session time that replaces the current one
+	if pi.StartDate != nil {
+		input.StartDate = *pi.StartDate
+		if strings.Contains(input.StartDate, "T") {
+			input.StartTime = ""
+		}
+	}
+	if pi.EndDate != nil {
+		input.EndDate = *pi.EndDate
+		if strings.Contains(input.EndDate, "T") {
+			input.EndTime, input.DurationMinutes = "", 0
+		}
+	}
+	if pi.StartTime != nil {
+		input.StartTime = *pi.StartTime
+	}
+	if pi.EndTime != nil {
+		input.EndTime, input.DurationMinutes = *pi.EndTime, 0
+	}
+	if pi.DurationMinutes != nil {
+		input.EndTime, input.DurationMinutes = "", *pi.DurationMinutes
+	}
+	if pi.Recurrence != nil {
+		input.Recurrence = *pi.Recurrence
+	}
+	if pi.ExDates != nil {
+		input.ExDates = *pi.ExDates
+	}
+	if pi.InstructorID != nil {
+		input.InstructorID = *pi.InstructorID
+	}
+	if pi.RoomID != nil {
+		input.RoomID = *pi.RoomID
+	}
+	if pi.Capacity != nil {
+		input.Capacity = *pi.Capacity
+	}
+	if pi.MinAttendance != nil {
+		input.MinAttendance = *pi.MinAttendance
+	}
+	if pi.CutoffMinutes != nil {
+		input.CutoffMinutes = *pi.CutoffMinutes
+	}
+	return input
+}
+
+// BookingConflict describes bookings on a class date that an update would strand
+type BookingConflict struct {
+	Date       time.Time `json:"date"`
+	Reason     string    `json:"reason"`
+	Booked     int       `json:"booked"`
+	Capacity   int       `json:"capacity"`
+	BookingIDs []string  `json:"bookingIds"`
+}
+
+// CapacityResolution decides what happens to the bookings above a reduced
+// class capacity
+type CapacityResolution string
+
+const (
+	// CapacityResolutionReject refuses a capacity below the booked count, or
+	// with force leaves the date overbooked
+	CapacityResolutionReject CapacityResolution = "reject"
+	// CapacityResolutionWaitlist keeps the earliest bookings and moves the
+	// rest to the front of the waitlist
+	CapacityResolutionWaitlist CapacityResolution = "waitlist"
+	// CapacityResolutionCancel keeps the earliest bookings and cancels the rest
+	CapacityResolutionCancel CapacityResolution = "cancel"
+)
+
+func ParseCapacityResolution(value string) (CapacityResolution, error) {
+	switch resolution := CapacityResolution(value); resolution {
+	case CapacityResolutionReject, CapacityResolutionWaitlist, CapacityResolutionCancel:
+		return resolution, nil
+	case "":
+		return CapacityResolutionReject, nil
+	}
+	return "", fmt.Errorf("invalid resolution %q, use reject, waitlist or cancel", value)
+}
+
+// ClassChangeOptions decides how a class change treats the upcoming bookings
+// it conflicts with
+type ClassChangeOptions struct {
+	// Force applies the change even if it strands bookings
+	Force bool
+	// Resolution settles the dates left with more bookings than spots
+	Resolution CapacityResolution
+	// Cancellation is recorded on the bookings cancelled by the change
+	Cancellation Cancellation
+}
+
+// ResolvesOverbooking reports whether dates left over capacity are settled by
+// moving bookings rather than rejected
+func (o ClassChangeOptions) ResolvesOverbooking() bool {
+	return o.Resolution == CapacityResolutionWaitlist || o.Resolution == CapacityResolutionCancel
+}
+
+// OverbookingReport lists the bookings of a class date moved to the waitlist
+// or cancelled because the capacity dropped below the booked count
+type OverbookingReport struct {
+	Date           time.Time          `json:"date"`
+	Resolution     CapacityResolution `json:"resolution"`
+	Capacity       int                `json:"capacity"`
+	KeptBookingIDs []string           `json:"keptBookingIds"`
+	Affected       []*Booking         `json:"affectedBookings"`
+}
+
+// ToInput returns the input that would create the class as it is now
+func (c *Class) ToInput() ClassInput {
+	exDates := make([]string, 0, len(c.ExDates))
+	for _, exDate := range c.ExDates {
+		exDates = append(exDates, exDate.Format(dateLayout))
+	}
+
+	return ClassInput{
+		ClassName:       c.ClassName,
+		Description:     c.Description,
+		Category:        c.Category,
+		Tags:            c.Tags,
+		Difficulty:      string(c.Difficulty),
+		StartDate:       c.StartDate.Format(dateLayout),
+		EndDate:         c.EndDate.Format(dateLayout),
+		StartTime:       c.StartTime,
+		DurationMinutes: c.DurationMinutes,
+		Recurrence:      c.Recurrence,
+		ExDates:         exDates,
+		InstructorID:    c.InstructorID,
+		RoomID:          c.RoomID,
+		Capacity:        c.Capacity,
+		MinAttendance:   c.MinAttendance,
+		CutoffMinutes:   c.CutoffMinutes,
+	}
+}
+
+// Updated validates the input and returns a copy of the class with it applied
+func (c *Class) Updated(input ClassInput) (*Class, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	schedule, _ := input.schedule()
+	difficulty, _ := ParseClassDifficulty(input.Difficulty)
+	now := time.Now()
+
+	updated := *c
+	updated.ClassName = input.ClassName
+	updated.Description = input.Description
+	updated.Category = NormalizeLabel(input.Category)
+	updated.Tags = normalizeTags(input.Tags)
+	updated.Difficulty = difficulty
+	updated.InstructorID = input.InstructorID
+	updated.RoomID = input.RoomID
+	schedule.apply(&updated)
+	updated.Capacity = input.Capacity
+	updated.MinAttendance = input.MinAttendance
+	updated.CutoffMinutes = input.CutoffMinutes
+	updated.UpdatedAt = &now
+	return &updated, nil
+}
+
+// ClassRemovalMode decides what happens to bookings when a class is removed
+type ClassRemovalMode string
+
+const (
+	// ClassRemovalReject refuses to delete a class that still has bookings
+	ClassRemovalReject ClassRemovalMode = "reject"
+	// ClassRemovalCascade cancels upcoming bookings and deletes the class
+	ClassRemovalCascade ClassRemovalMode = "cascade"
+	// ClassRemovalArchive cancels upcoming bookings and keeps the class and
+	// its history, archived
+	ClassRemovalArchive ClassRemovalMode = "archive"
+)
+
+func ParseClassRemovalMode(value string) (ClassRemovalMode, error) {
+	switch mode := ClassRemovalMode(value); mode {
+	case ClassRemovalReject, ClassRemovalCascade, ClassRemovalArchive:
+		return mode, nil
+	case "":
+		return ClassRemovalReject, nil
+	}
+	return "", fmt.Errorf("invalid mode %q, use reject, cascade or archive", value)
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxClosureDays bounds how long a single closure can last
+const maxClosureDays = 366
+
+// Closure is a day or range of days the studio is closed, e.g. a public
+// holiday or maintenance
+type Closure struct {
+	ID        string    `json:"id"`
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ClosureInput struct {
+	StartDate string `json:"startDate" binding:"required"`
+	// EndDate is the last closed day, the closure is a single day when empty
+	EndDate string `json:"endDate"`
+	Reason  string `json:"reason" binding:"required"`
+	// CancelBookings cancels the bookings that fall on the closure
+	CancelBookings bool `json:"cancelBookings"`
+}
+
+func (ci *ClosureInput) Validate() error {
+	if ci.Reason == "" {
+		return errors.New("reason is required")
+	}
+
+	startDate, err := ParseDate(ci.StartDate)
+	if err != nil {
+		return errors.New("invalid startDate format. Use YYYY-MM-DD")
+	}
+
+	if ci.EndDate != "" {
+		endDate, err := ParseDate(ci.EndDate)
+		if err != nil {
+			return errors.New("invalid endDate format. Use YYYY-MM-DD")
+		}
+		if endDate.Before(startDate) {
+			return errors.New("endDate must be after startDate")
+		}
+		if daysBetween(startDate, endDate) >= maxClosureDays {
+			return errors.New("a closure cannot last more than 366 days")
+		}
+	}
+
+	return nil
+}
+
+func NewClosure(input ClosureInput) (*Closure, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	startDate, _ := ParseDate(input.StartDate)
+	endDate := startDate
+	if input.EndDate != "" {
+		endDate, _ = ParseDate(input.EndDate)
+	}
+
+	return &Closure{
+		ID:        uuid.New().String(),
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    input.Reason,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Covers reports whether the studio is closed on the given date
+func (c *Closure) Covers(date time.Time) bool {
+	date = DateOf(date)
+	return !date.Before(c.StartDate) && !date.After(c.EndDate)
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"errors"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Instructor is a member of staff who teaches classes
+type Instructor struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Bio       string     `json:"bio,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	Phone     string     `json:"phone,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type InstructorInput struct {
+	Name  string `json:"name" binding:"required"`
+	Bio   string `json:"bio"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+func (ii *InstructorInput) Validate() error {
+	if ii.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if ii.Email != "" {
+		if _, err := mail.ParseAddress(ii.Email); err != nil {
+			return errors.New("invalid email address")
+		}
+	}
+
+	return nil
+}
+
+func NewInstructor(input InstructorInput) (*Instructor, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Instructor{
+		ID:        uuid.New().String(),
+		Name:      input.Name,
+		Bio:       input.Bio,
+		Email:     input.Email,
+		Phone:     input.Phone,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Updated validates the input and returns a copy of the instructor with it
+// applied
+func (i *Instructor) Updated(input InstructorInput) (*Instructor, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	updated := *i
+	updated.Name = input.Name
+	updated.Bio = input.Bio
+	updated.Email = input.Email
+	updated.Phone = input.Phone
+	updated.UpdatedAt = &now
+	return &updated, nil
+}
+
+// InstructorOn returns the ID of the instructor teaching the class on the
+// given date, taking a substitute of the occurrence into account
+func (c *Class) InstructorOn(date time.Time) string {
+	if override, exists := c.OverrideOn(date); exists && override.InstructorID != "" {
+		return override.InstructorID
+	}
+	return c.InstructorID
+}
+
+// InstructorIDs returns the IDs of every instructor assigned to the class or
+// to one of its occurrences
+func (c *Class) InstructorIDs() []string {
+	seen := make(map[string]bool)
+	ids := make([]string, 0)
+	if c.InstructorID != "" {
+		seen[c.InstructorID] = true
+		ids = append(ids, c.InstructorID)
+	}
+	for _, override := range c.Overrides {
+		if override.InstructorID != "" && !seen[override.InstructorID] {
+			seen[override.InstructorID] = true
+			ids = append(ids, override.InstructorID)
+		}
+	}
+	return ids
+}
+
+// InstructorOverlap returns the first date on which the instructor teaches
+// both classes at overlapping times
+func (c *Class) InstructorOverlap(other *Class, instructorID string) (time.Time, bool) {
+	return c.SessionOverlap(other, func(date time.Time) bool {
+		return c.InstructorOn(date) == instructorID && other.InstructorOn(date) == instructorID
+	})
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemberStatus tells whether a member can book classes
+type MemberStatus string
+
+const (
+	MemberStatusActive   MemberStatus = "active"
+	MemberStatusInactive MemberStatus = "inactive"
+)
+
+func ParseMemberStatus(value string) (MemberStatus, error) {
+	switch status := MemberStatus(value); status {
+	case MemberStatusActive, MemberStatusInactive:
+		return status, nil
+	case "":
+		return MemberStatusActive, nil
+	}
+	return "", fmt.Errorf("invalid member status %q, use active or inactive", value)
+}
+
+var ErrMemberInactive = errors.New("member is inactive and cannot book classes")
+
+// Member is a person who books classes at the studio
+type Member struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Email     string       `json:"email,omitempty"`
+	Phone     string       `json:"phone,omitempty"`
+	Status    MemberStatus `json:"status"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt *time.Time   `json:"updatedAt,omitempty"`
+}
+
+type MemberInput struct {
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	// Status is active or inactive. New members default to active, an
+	// update without it keeps the current status.
+	Status string `json:"status"`
+}
+
+func (mi *MemberInput) Validate() error {
+	if mi.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if mi.Email != "" {
+		if _, err := mail.ParseAddress(mi.Email); err != nil {
+			return errors.New("invalid email address")
+		}
+	}
+
+	if _, err := ParseMemberStatus(mi.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMember(input MemberInput) (*Member, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	status, _ := ParseMemberStatus(input.Status)
+	return &Member{
+		ID:        uuid.New().String(),
+		Name:      input.Name,
+		Email:     input.Email,
+		Phone:     input.Phone,
+		Status:    status,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Updated validates the input and returns a copy of the member with it
+// applied. An empty status keeps the current one.
+func (m *Member) Updated(input MemberInput) (*Member, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	status := m.Status
+	if input.Status != "" {
+		status, _ = ParseMemberStatus(input.Status)
+	}
+	now := time.Now()
+	updated := *m
+	updated.Name = input.Name
+	updated.Email = input.Email
+	updated.Phone = input.Phone
+	updated.Status = status
+	updated.UpdatedAt = &now
+	return &updated, nil
+}
+
+func (m *Member) IsActive() bool {
+	return m.Status == MemberStatusActive
+}
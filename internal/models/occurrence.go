@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrInvalidOccurrenceID = errors.New("invalid occurrence id, expected classId:YYYY-MM-DD")
+
+// Occurrence is a single date a class runs on, with the changes of its
+// override applied
+type Occurrence struct {
+	// ID identifies the occurrence as <classId>:<YYYY-MM-DD> and stays the
+	// same however the class schedule changes
+	ID           string    `json:"id"`
+	ClassID      string    `json:"classId"`
+	Date         time.Time `json:"date"`
+	Session      Session   `json:"session"`
+	Capacity     int       `json:"capacity"`
+	InstructorID string    `json:"instructorId,omitempty"`
+	RoomID       string    `json:"roomId,omitempty"`
+	// Overridden is set when the occurrence differs from the regular schedule
+	Overridden bool `json:"overridden,omitempty"`
+}
+
+// OccurrenceID returns the ID of the occurrence of a class on a date
+func OccurrenceID(classID string, date time.Time) string {
+	return classID + ":" + DateOf(date).Format(dateLayout)
+}
+
+// ParseOccurrenceID splits an occurrence ID into its class ID and date
+func ParseOccurrenceID(id string) (string, time.Time, error) {
+	separator := strings.LastIndex(id, ":")
+	if separator <= 0 {
+		return "", time.Time{}, ErrInvalidOccurrenceID
+	}
+
+	date, err := ParseDate(id[separator+1:])
+	if err != nil {
+		return "", time.Time{}, ErrInvalidOccurrenceID
+	}
+	return id[:separator], date, nil
+}
+
+// OccurrenceOn returns the occurrence of the class on the given date. It does
+// not check that the class runs on the date.
+func (c *Class) OccurrenceOn(date time.Time) Occurrence {
+	_, overridden := c.OverrideOn(date)
+	return Occurrence{
+		ID:           OccurrenceID(c.ID, date),
+		ClassID:      c.ID,
+		Date:         DateOf(date),
+		Session:      c.SessionOn(date),
+		Capacity:     c.CapacityOn(date),
+		InstructorID: c.InstructorOn(date),
+		RoomID:       c.RoomID,
+		Overridden:   overridden,
+	}
+}
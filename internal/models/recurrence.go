@@ -0,0 +1,210 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+)
+
+// RecurrenceRule is the subset of an RFC 5545 RRULE supported for class
+// schedules: FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY, COUNT and
+// UNTIL. The class start date is the first date of the recurrence.
+type RecurrenceRule struct {
+	Freq     string
+	Interval int
+	ByDay    []RecurrenceDay
+	Count    int
+	Until    time.Time
+}
+
+// RecurrenceDay is a BYDAY entry. A non-zero ordinal picks the nth (or, when
+// negative, nth last) weekday of the month and is only valid with MONTHLY.
+type RecurrenceDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// ParseRecurrenceRule parses an RRULE value such as
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA", with or without the "RRULE:" prefix
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	rule := RecurrenceRule{Interval: 1}
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, found := strings.Cut(part, "=")
+		if !found || val == "" {
+			return rule, fmt.Errorf("invalid recurrence part %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			if val != FrequencyDaily && val != FrequencyWeekly && val != FrequencyMonthly {
+				return rule, fmt.Errorf("unsupported recurrence FREQ %q, use DAILY, WEEKLY or MONTHLY", val)
+			}
+			rule.Freq = val
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return rule, errors.New("recurrence INTERVAL must be a positive number")
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return rule, errors.New("recurrence COUNT must be a positive number")
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceDate(val)
+			if err != nil {
+				return rule, err
+			}
+			rule.Until = until
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				byDay, err := parseRecurrenceDay(day)
+				if err != nil {
+					return rule, err
+				}
+				rule.ByDay = append(rule.ByDay, byDay)
+			}
+		default:
+			return rule, fmt.Errorf("unsupported recurrence part %s", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return rule, errors.New("recurrence FREQ is required")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return rule, errors.New("recurrence cannot have both COUNT and UNTIL")
+	}
+	for _, day := range rule.ByDay {
+		if day.Ordinal != 0 && rule.Freq != FrequencyMonthly {
+			return rule, errors.New("numbered BYDAY values are only allowed with FREQ=MONTHLY")
+		}
+	}
+	return rule, nil
+}
+
+// parseRecurrenceDay parses a BYDAY entry such as MO, 2SA or -1FR
+func parseRecurrenceDay(value string) (RecurrenceDay, error) {
+	var day RecurrenceDay
+	if len(value) < 2 {
+		return day, fmt.Errorf("invalid BYDAY value %q", value)
+	}
+
+	weekday, err := ParseWeekday(value[len(value)-2:])
+	if err != nil {
+		return day, fmt.Errorf("invalid BYDAY value %q", value)
+	}
+	day.Weekday = weekday
+
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		day.Ordinal, err = strconv.Atoi(ordinal)
+		if err != nil || day.Ordinal == 0 || day.Ordinal < -5 || day.Ordinal > 5 {
+			return day, fmt.Errorf("invalid BYDAY value %q", value)
+		}
+	}
+	return day, nil
+}
+
+// parseRecurrenceDate parses an UNTIL value in the RFC 5545 DATE (20060102) or
+// UTC DATE-TIME (20060102T150405Z) form
+func parseRecurrenceDate(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return DateOf(t), nil
+	}
+	t, err := time.ParseInLocation("20060102", value, studioLocation)
+	if err != nil {
+		return time.Time{}, errors.New("invalid recurrence UNTIL, use YYYYMMDD or YYYYMMDDTHHMMSSZ")
+	}
+	return t, nil
+}
+
+// Occurs reports whether the recurrence starting on start includes date
+func (rr RecurrenceRule) Occurs(start, date time.Time) bool {
+	start, date = DateOf(start), DateOf(date)
+	if date.Before(start) || (!rr.Until.IsZero() && date.After(rr.Until)) {
+		return false
+	}
+	if !rr.matches(start, date) {
+		return false
+	}
+	if rr.Count == 0 {
+		return true
+	}
+
+	// COUNT bounds the number of occurrences from the start date
+	occurrences := 0
+	for day := start; !day.After(date); day = day.AddDate(0, 0, 1) {
+		if rr.matches(start, day) {
+			occurrences++
+		}
+	}
+	return occurrences <= rr.Count
+}
+
+// matches reports whether date fits the frequency, interval and BYDAY of the
+// rule, ignoring COUNT and UNTIL
+func (rr RecurrenceRule) matches(start, date time.Time) bool {
+	switch rr.Freq {
+	case FrequencyDaily:
+		return daysBetween(start, date)%rr.Interval == 0 && rr.matchesWeekday(date, start.Weekday(), true)
+	case FrequencyWeekly:
+		weeks := daysBetween(startOfWeek(start), startOfWeek(date)) / 7
+		return weeks%rr.Interval == 0 && rr.matchesWeekday(date, start.Weekday(), false)
+	case FrequencyMonthly:
+		months := (date.Year()-start.Year())*12 + int(date.Month()-start.Month())
+		if months%rr.Interval != 0 {
+			return false
+		}
+		if len(rr.ByDay) == 0 {
+			return date.Day() == start.Day()
+		}
+		return rr.matchesWeekday(date, start.Weekday(), false)
+	}
+	return false
+}
+
+// matchesWeekday checks date against BYDAY. Without BYDAY every day matches
+// when anyDay is set, otherwise only the weekday of the start date.
+func (rr RecurrenceRule) matchesWeekday(date time.Time, startWeekday time.Weekday, anyDay bool) bool {
+	if len(rr.ByDay) == 0 {
+		return anyDay || date.Weekday() == startWeekday
+	}
+
+	nth := (date.Day()-1)/7 + 1
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	nthLast := -((daysInMonth-date.Day())/7 + 1)
+	for _, day := range rr.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		if day.Ordinal == 0 || day.Ordinal == nth || day.Ordinal == nthLast {
+			return true
+		}
+	}
+	return false
+}
+
+// daysBetween returns the number of calendar days from one date to another
+func daysBetween(from, to time.Time) int {
+	// Count calendar days in UTC, where every day lasts 24 hours
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// startOfWeek returns the Monday of the week a date falls in
+func startOfWeek(date time.Time) time.Time {
+	return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+}
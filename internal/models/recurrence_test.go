@@ -0,0 +1,156 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassDates_Recurrence(t *testing.T) {
+	tests := []struct {
+		name       string
+		startDate  string
+		endDate    string
+		recurrence string
+		exDates    []string
+		want       []string
+	}{
+		{
+			name:       "weekly on two days with COUNT",
+			startDate:  "2030-03-04",
+			endDate:    "2030-06-30",
+			recurrence: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5",
+			want:       []string{"2030-03-04", "2030-03-06", "2030-03-11", "2030-03-13", "2030-03-18"},
+		},
+		{
+			name:       "every other day with UNTIL",
+			startDate:  "2030-03-04",
+			endDate:    "2030-06-30",
+			recurrence: "RRULE:FREQ=DAILY;INTERVAL=2;UNTIL=20300310",
+			want:       []string{"2030-03-04", "2030-03-06", "2030-03-08", "2030-03-10"},
+		},
+		{
+			name:       "UNTIL as a UTC date-time",
+			startDate:  "2030-03-04",
+			endDate:    "2030-06-30",
+			recurrence: "FREQ=WEEKLY;UNTIL=20300318T090000Z",
+			want:       []string{"2030-03-04", "2030-03-11", "2030-03-18"},
+		},
+		{
+			name:       "second Saturday of the month",
+			startDate:  "2030-01-01",
+			endDate:    "2030-04-30",
+			recurrence: "FREQ=MONTHLY;BYDAY=2SA",
+			want:       []string{"2030-01-12", "2030-02-09", "2030-03-09", "2030-04-13"},
+		},
+		{
+			name:       "last Friday of every other month",
+			startDate:  "2030-01-01",
+			endDate:    "2030-06-30",
+			recurrence: "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR",
+			want:       []string{"2030-01-25", "2030-03-29", "2030-05-31"},
+		},
+		{
+			name:       "monthly on the start day",
+			startDate:  "2030-01-15",
+			endDate:    "2030-04-30",
+			recurrence: "FREQ=MONTHLY;COUNT=3",
+			want:       []string{"2030-01-15", "2030-02-15", "2030-03-15"},
+		},
+		{
+			name:       "every other week with an excluded date",
+			startDate:  "2030-03-04",
+			endDate:    "2030-04-10",
+			recurrence: "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA",
+			exDates:    []string{"2030-03-23"},
+			want:       []string{"2030-03-09", "2030-04-06"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, err := NewClass(ClassInput{
+				ClassName:  "Yoga",
+				StartDate:  tt.startDate,
+				EndDate:    tt.endDate,
+				Recurrence: tt.recurrence,
+				ExDates:    tt.exDates,
+				Capacity:   10,
+			})
+			require.NoError(t, err)
+
+			dates, err := class.Dates(time.Time{}, time.Time{})
+			require.NoError(t, err)
+			got := make([]string, 0, len(dates))
+			for _, date := range dates {
+				got = append(got, date.Format(dateLayout))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"missing FREQ", "BYDAY=MO"},
+		{"unsupported FREQ", "FREQ=YEARLY"},
+		{"COUNT and UNTIL", "FREQ=DAILY;COUNT=3;UNTIL=20300310"},
+		{"numbered BYDAY without MONTHLY", "FREQ=WEEKLY;BYDAY=2MO"},
+		{"BYDAY ordinal out of range", "FREQ=MONTHLY;BYDAY=6MO"},
+		{"zero INTERVAL", "FREQ=DAILY;INTERVAL=0"},
+		{"unsupported part", "FREQ=DAILY;BYMONTH=3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRecurrenceRule(tt.value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestClassDates_Range(t *testing.T) {
+	// The class starts after today, so the default window starts with it
+	class, err := NewClass(ClassInput{
+		ClassName: "Yoga",
+		StartDate: "2090-01-01",
+		EndDate:   "2091-06-30",
+		Capacity:  10,
+	})
+	require.NoError(t, err)
+	date := func(value string) time.Time {
+		parsed, err := ParseDate(value)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		wantFirst string
+		wantLast  string
+		wantErr   bool
+	}{
+		{"defaults limited to a year", time.Time{}, time.Time{}, "2090-01-01", "2091-01-02", false},
+		{"from given", date("2090-06-01"), time.Time{}, "2090-06-01", "2091-06-02", false},
+		{"to given", time.Time{}, date("2091-06-30"), "2090-06-29", "2091-06-30", false},
+		{"range given within a year", date("2090-03-01"), date("2090-03-31"), "2090-03-01", "2090-03-31", false},
+		{"range given over a year", date("2090-01-01"), date("2091-06-30"), "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates, err := class.Dates(tt.from, tt.to)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, dates)
+			assert.Equal(t, tt.wantFirst, dates[0].Format(dateLayout))
+			assert.Equal(t, tt.wantLast, dates[len(dates)-1].Format(dateLayout))
+		})
+	}
+}
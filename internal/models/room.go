@@ -0,0 +1,91 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Room is a studio space classes take place in
+type Room struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Capacity is the number of people the room physically holds
+	Capacity  int        `json:"capacity"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type RoomInput struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	Capacity    int    `json:"capacity" binding:"required,min=1"`
+}
+
+func (ri *RoomInput) Validate() error {
+	if ri.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if ri.Capacity < 1 {
+		return errors.New("capacity must be at least 1")
+	}
+
+	return nil
+}
+
+func NewRoom(input RoomInput) (*Room, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Room{
+		ID:          uuid.New().String(),
+		Name:        input.Name,
+		Description: input.Description,
+		Capacity:    input.Capacity,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Updated validates the input and returns a copy of the room with it applied
+func (r *Room) Updated(input RoomInput) (*Room, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	updated := *r
+	updated.Name = input.Name
+	updated.Description = input.Description
+	updated.Capacity = input.Capacity
+	updated.UpdatedAt = &now
+	return &updated, nil
+}
+
+// Fits returns an error when the class, or one of its occurrences, holds more
+// people than the room
+func (r *Room) Fits(class *Class) error {
+	if class.Capacity > r.Capacity {
+		return fmt.Errorf("capacity %d exceeds the capacity of room %s (%d)", class.Capacity, r.Name, r.Capacity)
+	}
+	for _, override := range class.Overrides {
+		if override.Capacity > r.Capacity {
+			return fmt.Errorf("capacity %d on %s exceeds the capacity of room %s (%d)",
+				override.Capacity, override.Date.Format(dateLayout), r.Name, r.Capacity)
+		}
+	}
+	return nil
+}
+
+// RoomOverlap returns the first date on which both classes use the same room
+// at overlapping times
+func (c *Class) RoomOverlap(other *Class) (time.Time, bool) {
+	if c.RoomID == "" || c.RoomID != other.RoomID {
+		return time.Time{}, false
+	}
+	return c.SessionOverlap(other, func(time.Time) bool { return true })
+}
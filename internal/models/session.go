@@ -0,0 +1,214 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout        = "2006-01-02"
+	sessionTimeLayout = "15:04"
+	minutesPerDay     = 24 * 60
+)
+
+// Session is the occurrence of a class on one date, from its start to its end
+type Session struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// SessionOn returns the session a class runs on the given date, taking a time
+// change of the occurrence into account. Classes without a start time run all
+// day.
+func (c *Class) SessionOn(date time.Time) Session {
+	startTime, durationMinutes := c.StartTime, c.DurationMinutes
+	if override, exists := c.OverrideOn(date); exists {
+		if override.StartTime != "" {
+			startTime = override.StartTime
+		}
+		if override.DurationMinutes != 0 {
+			durationMinutes = override.DurationMinutes
+		}
+	}
+
+	day := DateOf(date)
+	startMinute := 0
+	if startTime != "" {
+		clock, _ := time.Parse(sessionTimeLayout, startTime)
+		startMinute = clock.Hour()*60 + clock.Minute()
+	}
+
+	// Build the start from the wall clock so sessions keep their local time
+	// across daylight saving changes
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, startMinute, 0, 0, day.Location())
+
+	// Sessions without a length run until the end of the day
+	if durationMinutes == 0 || startMinute+durationMinutes > minutesPerDay {
+		return Session{Start: start, End: day.AddDate(0, 0, 1)}
+	}
+	return Session{Start: start, End: start.Add(time.Duration(durationMinutes) * time.Minute)}
+}
+
+// Overlaps reports whether two sessions share any time
+func (s Session) Overlaps(other Session) bool {
+	return s.Start.Before(other.End) && other.Start.Before(s.End)
+}
+
+// DateOf returns the calendar date of a time in the studio timezone, as the
+// start of that day
+func DateOf(t time.Time) time.Time {
+	t = t.In(studioLocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, studioLocation)
+}
+
+// parseDateOrDateTime parses a YYYY-MM-DD date or an RFC 3339 datetime and
+// reports whether a time of day was given. Datetimes are converted to the
+// studio timezone.
+func parseDateOrDateTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.In(studioLocation), true, nil
+	}
+
+	date, err := ParseDate(value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return date, false, nil
+}
+
+// classSchedule is the date range and daily session described by a ClassInput
+type classSchedule struct {
+	startDate       time.Time
+	endDate         time.Time
+	startTime       string
+	durationMinutes int
+	recurrence      string
+	exDates         []time.Time
+}
+
+// schedule parses the dates and session times of the input. An RFC 3339
+// startDate or endDate supplies the session start or end time when startTime,
+// endTime and durationMinutes are not given.
+func (ci *ClassInput) schedule() (classSchedule, error) {
+	var s classSchedule
+
+	startDate, startHasTime, err := parseDateOrDateTime(ci.StartDate)
+	if err != nil {
+		return s, errors.New("invalid startDate format. Use YYYY-MM-DD or RFC 3339")
+	}
+	endDate, endHasTime, err := parseDateOrDateTime(ci.EndDate)
+	if err != nil {
+		return s, errors.New("invalid endDate format. Use YYYY-MM-DD or RFC 3339")
+	}
+
+	s.startDate = DateOf(startDate)
+	s.endDate = DateOf(endDate)
+	if s.endDate.Before(s.startDate) {
+		return s, errors.New("endDate must be after startDate")
+	}
+
+	if ci.Recurrence != "" {
+		if _, err := ParseRecurrenceRule(ci.Recurrence); err != nil {
+			return s, err
+		}
+		s.recurrence = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(ci.Recurrence)), "RRULE:")
+	}
+	for _, value := range ci.ExDates {
+		exDate, _, err := parseDateOrDateTime(value)
+		if err != nil {
+			return s, fmt.Errorf("invalid exDate %q. Use YYYY-MM-DD", value)
+		}
+		s.exDates = append(s.exDates, DateOf(exDate))
+	}
+
+	startTime, endTime := ci.StartTime, ci.EndTime
+	if startTime == "" && startHasTime {
+		startTime = startDate.Format(sessionTimeLayout)
+	}
+	if endTime == "" && ci.DurationMinutes == 0 && endHasTime {
+		endTime = endDate.Format(sessionTimeLayout)
+	}
+
+	s.startTime, s.durationMinutes, err = sessionTimes(startTime, endTime, ci.DurationMinutes, true)
+	return s, err
+}
+
+// sessionTimes validates a HH:MM session start time with its end time or
+// duration, and returns the normalized start time and the duration in
+// minutes. Without requireLength a start time may be given on its own.
+func sessionTimes(startTime, endTime string, durationMinutes int, requireLength bool) (string, int, error) {
+	if durationMinutes < 0 {
+		return "", 0, errors.New("durationMinutes must be positive")
+	}
+	if startTime == "" {
+		if endTime != "" || (requireLength && durationMinutes != 0) {
+			return "", 0, errors.New("startTime is required with endTime or durationMinutes")
+		}
+		return "", durationMinutes, nil
+	}
+
+	start, err := time.Parse(sessionTimeLayout, startTime)
+	if err != nil {
+		return "", 0, errors.New("invalid startTime format. Use HH:MM")
+	}
+	startMinute := start.Hour()*60 + start.Minute()
+
+	switch {
+	case endTime != "" && durationMinutes != 0:
+		return "", 0, errors.New("use either endTime or durationMinutes, not both")
+	case endTime != "":
+		end, err := time.Parse(sessionTimeLayout, endTime)
+		if err != nil {
+			return "", 0, errors.New("invalid endTime format. Use HH:MM")
+		}
+		durationMinutes = end.Hour()*60 + end.Minute() - startMinute
+		if durationMinutes <= 0 {
+			return "", 0, errors.New("endTime must be after startTime")
+		}
+	case durationMinutes == 0 && requireLength:
+		return "", 0, errors.New("endTime or durationMinutes is required with startTime")
+	}
+
+	if startMinute+durationMinutes > minutesPerDay {
+		return "", 0, fmt.Errorf("a session starting at %s cannot last past midnight", startTime)
+	}
+	return start.Format(sessionTimeLayout), durationMinutes, nil
+}
+
+// apply sets the schedule fields of a class
+func (s classSchedule) apply(c *Class) {
+	c.StartDate = s.startDate
+	c.EndDate = s.endDate
+	c.StartTime = s.startTime
+	c.DurationMinutes = s.durationMinutes
+	c.Recurrence = s.recurrence
+	c.ExDates = s.exDates
+	c.EndTime = ""
+	if s.startTime != "" {
+		c.EndTime = c.SessionOn(s.startDate).End.Format(sessionTimeLayout)
+	}
+}
+
+// SessionOverlap returns the first date on which both classes run at
+// overlapping times, considering only the dates shared reports true for
+func (c *Class) SessionOverlap(other *Class, shared func(date time.Time) bool) (time.Time, bool) {
+	from, to := c.StartDate, c.EndDate
+	if other.StartDate.After(from) {
+		from = other.StartDate
+	}
+	if other.EndDate.Before(to) {
+		to = other.EndDate
+	}
+
+	for date := DateOf(from); !date.After(to); date = date.AddDate(0, 0, 1) {
+		if !shared(date) || !c.IsDateInRange(date) || !other.IsDateInRange(date) {
+			continue
+		}
+		if c.SessionOn(date).Overlaps(other.SessionOn(date)) {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
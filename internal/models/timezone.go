@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// studioLocation is the timezone of the studio. Dates are calendar days in it
+// and class session times are wall clock times in it.
+var studioLocation = time.UTC
+
+// SetStudioLocation sets the timezone of the studio. It is meant to be called
+// once at startup, before any request is served.
+func SetStudioLocation(location *time.Location) {
+	studioLocation = location
+}
+
+// StudioLocation returns the timezone of the studio
+func StudioLocation() *time.Location {
+	return studioLocation
+}
+
+// ParseDate parses a YYYY-MM-DD date as the start of that day in the studio
+// timezone
+func ParseDate(value string) (time.Time, error) {
+	return time.ParseInLocation(dateLayout, value, studioLocation)
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistEntry is a member queued for a full class on a given date. The
+// entry tracks a booking held in the waitlisted status until it is promoted.
+type WaitlistEntry struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	ClassID    string     `json:"classId"`
+	Date       time.Time  `json:"date"`
+	Position   int        `json:"position,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	PromotedAt *time.Time `json:"promotedAt,omitempty"`
+	BookingID  string     `json:"bookingId,omitempty"`
+}
+
+func NewWaitlistEntry(booking *Booking) *WaitlistEntry {
+	return &WaitlistEntry{
+		ID:        uuid.New().String(),
+		Name:      booking.Name,
+		ClassID:   booking.ClassID,
+		Date:      booking.Date,
+		CreatedAt: time.Now(),
+		BookingID: booking.ID,
+	}
+}
+
+func (e *WaitlistEntry) IsPromoted() bool {
+	return e.PromotedAt != nil
+}
+
+// Promote confirms the waitlisted booking behind the entry
+func (e *WaitlistEntry) Promote(booking *Booking, now time.Time) error {
+	if err := booking.TransitionTo(BookingStatusConfirmed, now); err != nil {
+		return err
+	}
+
+	e.PromotedAt = &now
+	return nil
+}
@@ -1,48 +1,299 @@
 package repositories
 
 import (
-	"errors"
 	"glofox-backend/internal/models"
+	"sort"
 	"sync"
 	"time"
 )
 
+// BookingRepository stores bookings. Implementations must reject a booking
+// that duplicates a live booking of the same member for the same class and
+// date with a *DuplicateBookingError.
 type BookingRepository interface {
 	Create(booking *models.Booking) error
 	GetAll() []*models.Booking
 	GetByID(id string) (*models.Booking, error)
 	GetByClassAndDate(classID string, date time.Time) []*models.Booking
+	// CountByClassAndDate returns how many spots are taken on a class date and
+	// how many members are waiting for one
+	CountByClassAndDate(classID string, date time.Time) (booked int, waitlisted int)
+	// Find returns the bookings matching every set field of the query, ordered
+	// by date and then creation time
+	Find(query BookingQuery) []*models.Booking
+	// UpdateStatus moves a booking to a new status if the transition is
+	// allowed. Waitlisted bookings cannot be confirmed this way, they are
+	// promoted from the waitlist.
+	UpdateStatus(id string, status models.BookingStatus) (*models.Booking, error)
+	// Reschedule moves a booking to another class and/or date. A non-zero
+	// start must match the session of the target class on that date. The
+	// booking is left untouched if the target is unavailable.
+	Reschedule(id string, classID string, date time.Time, start time.Time) (*models.Booking, error)
+	// Cancel records the cancellation on a booking and promotes the first
+	// waitlisted member into the freed spot
+	Cancel(id string, cancellation *models.Cancellation) (*models.Booking, error)
+	// LinkMember links the bookings held under a name that are not linked to
+	// a member yet to the member, and returns them. A live booking for a class
+	// date the member already holds another live booking for is left unlinked
+	// and returned as skipped.
+	LinkMember(name string, memberID string) (linked []*models.Booking, skipped []*models.Booking)
+
+	// CreateOrWaitlist stores the booking if the class has a free spot, or
+	// queues the member on the waitlist and returns the entry if it is full
+	CreateOrWaitlist(booking *models.Booking) (*models.WaitlistEntry, error)
+	GetWaitlist(classID string, date time.Time) []*models.WaitlistEntry
+	GetWaitlistEntry(id string) (*models.WaitlistEntry, error)
+	GetPromotions(classID string, date time.Time) []*models.WaitlistEntry
+	// PromoteWaitlist fills any free spots on a class date from its waitlist
+	PromoteWaitlist(classID string, date time.Time) ([]*models.WaitlistEntry, error)
+
+	// ApplyClassUpdate builds a new version of a class with update and stores
+	// it. The class is read and stored under the same lock as occurrence
+	// overrides, so the update cannot undo one made meanwhile. An error from
+	// update is returned as is. An update that would strand upcoming bookings
+	// is rejected with a *ClassUpdateConflictError, or stored anyway with
+	// force and the conflicts returned. Members waiting for a date the
+	// update removes are listed with its conflict, and cancelled with the
+	// options cancellation when forced. A resolution other than reject
+	// settles dates left over capacity instead, reporting the bookings it
+	// moved. Freed capacity is filled from the waitlist.
+	ApplyClassUpdate(classID string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error)
+	// RemoveClass deletes or archives a class according to the mode and
+	// returns the bookings it affected. Upcoming bookings are cancelled with
+	// the given cancellation in cascade and archive modes.
+	RemoveClass(classID string, mode models.ClassRemovalMode, cancellation models.Cancellation) ([]*models.Booking, error)
+	// SetClassOverride stores the override of one class occurrence, or removes
+	// it when override is nil. Bookings on a cancelled occurrence are
+	// cancelled with the options cancellation and returned. A capacity below
+	// the booked count is rejected with a *ClassUpdateConflictError unless
+	// force is set or a resolution settles it.
+	SetClassOverride(classID string, date time.Time, override *models.ClassOverride, options models.ClassChangeOptions) ([]*models.Booking, []models.BookingConflict, []models.OverbookingReport, error)
+	// CancelUnderAttended cancels a class occurrence and its bookings with
+	// the given cancellation when it has fewer bookings than the class
+	// minimum attendance, keeping any other change made to the occurrence.
+	// The bookings are counted and the occurrence cancelled under the same
+	// lock, so a booking made meanwhile is either counted or cancelled. It
+	// returns the booked count and whether the occurrence was cancelled.
+	CancelUnderAttended(classID string, date time.Time, cancellation models.Cancellation) (booked int, cancelled bool, bookings []*models.Booking, err error)
+	// CancelByDateRange cancels every booking of any class from one date to
+	// another (inclusive) and returns them, e.g. when the studio closes
+	CancelByDateRange(from, to time.Time, cancellation models.Cancellation) []*models.Booking
+}
+
+// BookingQuery filters bookings. Zero-valued fields are not filtered on, and
+// time bounds are inclusive. Name matches case-insensitively and Unlinked
+// matches bookings not linked to a member.
+type BookingQuery struct {
+	ClassID     string
+	SeriesID    string
+	MemberID    string
+	Name        string
+	Unlinked    bool
+	Statuses    []models.BookingStatus
+	DateFrom    time.Time
+	DateTo      time.Time
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// Matches reports whether a booking satisfies the query
+func (q BookingQuery) Matches(booking *models.Booking) bool {
+	if q.ClassID != "" && booking.ClassID != q.ClassID {
+		return false
+	}
+	if q.SeriesID != "" && booking.SeriesID != q.SeriesID {
+		return false
+	}
+	if q.MemberID != "" && booking.MemberID != q.MemberID {
+		return false
+	}
+	if q.Name != "" && models.NormalizeName(booking.Name) != models.NormalizeName(q.Name) {
+		return false
+	}
+	if q.Unlinked && booking.MemberID != "" {
+		return false
+	}
+	if len(q.Statuses) > 0 && !containsStatus(q.Statuses, booking.Status) {
+		return false
+	}
+	if !q.DateFrom.IsZero() && booking.Date.Before(q.DateFrom) {
+		return false
+	}
+	if !q.DateTo.IsZero() && booking.Date.After(q.DateTo) {
+		return false
+	}
+	if !q.CreatedFrom.IsZero() && booking.CreatedAt.Before(q.CreatedFrom) {
+		return false
+	}
+	if !q.CreatedTo.IsZero() && booking.CreatedAt.After(q.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+func containsStatus(statuses []models.BookingStatus, status models.BookingStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
 }
 
 type InMemoryBookingRepository struct {
-	bookings  map[string]*models.Booking
+	bookings map[string]*models.Booking
+	// slots indexes booking IDs by class and date so per-date lookups do not
+	// scan every booking
+	slots     map[string][]string
+	waitlist  map[string]*models.WaitlistEntry
+	queues    map[string][]string
 	classRepo ClassRepository
+	closures  ClosureChecker
 	mutex     sync.RWMutex
 }
 
-func NewBookingRepository(classRepo ClassRepository) BookingRepository {
+func NewBookingRepository(classRepo ClassRepository, closures ClosureChecker) BookingRepository {
 	return &InMemoryBookingRepository{
 		bookings:  make(map[string]*models.Booking),
+		slots:     make(map[string][]string),
+		waitlist:  make(map[string]*models.WaitlistEntry),
+		queues:    make(map[string][]string),
 		classRepo: classRepo,
+		closures:  closures,
 	}
 }
 
+// slotKey identifies a class on a specific date
+func slotKey(classID string, date time.Time) string {
+	return classID + "|" + models.DateOf(date).Format("2006-01-02")
+}
+
+// Create stores a booking after checking the class schedule and capacity.
+// The capacity check and the insert happen under the same lock so concurrent
+// requests cannot overbook a class.
 func (r *InMemoryBookingRepository) Create(booking *models.Booking) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.checkAvailability(booking); err != nil {
+		return err
+	}
+	if err := booking.TransitionTo(models.BookingStatusConfirmed, time.Now()); err != nil {
+		return err
+	}
+
+	r.insert(booking.Clone())
+	return nil
+}
+
+// insert stores a booking and indexes it by class and date. The caller keeps
+// the booking it passed in, so a copy must be stored when the caller goes on
+// to read it. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) insert(booking *models.Booking) {
+	key := slotKey(booking.ClassID, booking.Date)
+	r.bookings[booking.ID] = booking
+	r.slots[key] = append(r.slots[key], booking.ID)
+}
+
+// cloneBookings copies the bookings so they can be returned to callers, who
+// read them without holding the mutex
+func cloneBookings(bookings []*models.Booking) []*models.Booking {
+	cloned := make([]*models.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		cloned = append(cloned, booking.Clone())
+	}
+	return cloned
+}
+
+// unindex removes a booking from the class and date index. It expects the
+// caller to hold the mutex.
+func (r *InMemoryBookingRepository) unindex(booking *models.Booking) {
+	key := slotKey(booking.ClassID, booking.Date)
+	ids := r.slots[key]
+	for i, id := range ids {
+		if id == booking.ID {
+			ids = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+
+	if len(ids) == 0 {
+		delete(r.slots, key)
+	} else {
+		r.slots[key] = ids
+	}
+}
+
+// checkAvailability validates that the booked class runs on the requested
+// date, that the member is not already booked on it and that it still has a
+// free spot. The booking is pinned to the class session on that date even
+// when it has no spot. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) checkAvailability(booking *models.Booking) error {
 	// Check if class exists
 	class, err := r.classRepo.GetByID(booking.ClassID)
 	if err != nil {
-		return errors.New("class not found")
+		return ErrClassNotFound
+	}
+
+	if class.IsArchived() {
+		return ErrClassArchived
+	}
+
+	if class.IsCancelledOn(booking.Date) {
+		return models.ErrOccurrenceCancelled
 	}
 
 	// Check if booking date is within class date range
 	if !class.IsDateInRange(booking.Date) {
-		return errors.New("no class available on the requested date")
+		return ErrDateOutOfRange
 	}
 
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	// Check if the studio is closed on the requested date
+	if closure := r.closures.ClosureOn(booking.Date); closure != nil {
+		return &StudioClosedError{Closure: closure}
+	}
 
-	r.bookings[booking.ID] = booking
+	// Check if the class has a session at the requested time
+	session := class.SessionOn(booking.Date)
+	if !booking.Session.Start.IsZero() && !booking.Session.Start.Equal(session.Start) {
+		return ErrNoSessionAtTime
+	}
+
+	// Pin the session before checking for a spot, so a booking sent to the
+	// waitlist holds it as well
+	booking.Session = session
+	booking.OccurrenceID = models.OccurrenceID(class.ID, booking.Date)
+
+	// Check if the member already holds a booking for this class date
+	if existing := r.findDuplicate(booking); existing != nil {
+		return &DuplicateBookingError{ExistingBookingID: existing.ID}
+	}
+
+	// Check if there is a spot left on the requested date
+	booked := len(r.getByClassAndDate(booking.ClassID, booking.Date))
+	if capacity := class.CapacityOn(booking.Date); booked >= capacity {
+		return &ClassFullError{
+			ClassID:  class.ID,
+			Date:     booking.Date,
+			Capacity: capacity,
+			Booked:   booked,
+		}
+	}
+	return nil
+}
+
+// findDuplicate returns the live booking the same member holds for the same
+// class date, if any. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) findDuplicate(booking *models.Booking) *models.Booking {
+	for _, id := range r.slots[slotKey(booking.ClassID, booking.Date)] {
+		existing := r.bookings[id]
+		if existing.ID == booking.ID || existing.IsCancelled() {
+			continue
+		}
+		if existing.SameMember(booking) {
+			return existing
+		}
+	}
 	return nil
 }
 
@@ -53,7 +304,7 @@ func (r *InMemoryBookingRepository) GetAll() []*models.Booking {
 
 	bookings := make([]*models.Booking, 0, len(r.bookings))
 	for _, booking := range r.bookings {
-		bookings = append(bookings, booking)
+		bookings = append(bookings, booking.Clone())
 	}
 	return bookings
 }
@@ -65,25 +316,219 @@ func (r *InMemoryBookingRepository) GetByID(id string) (*models.Booking, error)
 
 	booking, exists := r.bookings[id]
 	if !exists {
-		return nil, errors.New("booking not found")
+		return nil, ErrBookingNotFound
+	}
+	return booking.Clone(), nil
+}
+
+func (r *InMemoryBookingRepository) Cancel(id string, cancellation *models.Cancellation) (*models.Booking, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	booking, exists := r.bookings[id]
+	if !exists {
+		return nil, ErrBookingNotFound
+	}
+	if booking.IsCancelled() {
+		return nil, models.ErrBookingAlreadyCancelled
+	}
+
+	wasWaitlisted := booking.Status == models.BookingStatusWaitlisted
+	if err := r.cancelLocked(booking, *cancellation); err != nil {
+		return nil, err
+	}
+
+	if wasWaitlisted {
+		return booking.Clone(), nil
+	}
+
+	if class, err := r.classRepo.GetByID(booking.ClassID); err == nil {
+		r.promoteWaitlist(class, booking.Date, cancellation.CancelledAt)
+	}
+	return booking.Clone(), nil
+}
+
+// cancelLocked cancels a booking and takes it off the waitlist if it was
+// waiting. It does not promote anyone into the freed spot. It expects the
+// caller to hold the mutex.
+func (r *InMemoryBookingRepository) cancelLocked(booking *models.Booking, cancellation models.Cancellation) error {
+	wasWaitlisted := booking.Status == models.BookingStatusWaitlisted
+	if err := booking.TransitionTo(models.BookingStatusCancelled, cancellation.CancelledAt); err != nil {
+		return err
+	}
+	booking.Cancellation = &cancellation
+
+	if wasWaitlisted {
+		r.removeFromWaitlist(booking)
+	}
+	return nil
+}
+
+func (r *InMemoryBookingRepository) UpdateStatus(id string, status models.BookingStatus) (*models.Booking, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	booking, exists := r.bookings[id]
+	if !exists {
+		return nil, ErrBookingNotFound
+	}
+
+	// A waitlisted booking only takes a spot through the waitlist, which
+	// checks the capacity and keeps the queue in order
+	if booking.Status == models.BookingStatusWaitlisted && status == models.BookingStatusConfirmed {
+		return nil, &models.InvalidTransitionError{From: booking.Status, To: status}
+	}
+
+	if err := booking.TransitionTo(status, time.Now()); err != nil {
+		return nil, err
+	}
+	return booking.Clone(), nil
+}
+
+func (r *InMemoryBookingRepository) LinkMember(name string, memberID string) ([]*models.Booking, []*models.Booking) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := models.NormalizeName(name)
+	linked := make([]*models.Booking, 0)
+	skipped := make([]*models.Booking, 0)
+	for _, booking := range r.bookings {
+		if booking.MemberID != "" || models.NormalizeName(booking.Name) != key {
+			continue
+		}
+		if !booking.IsCancelled() && r.holdsLiveBooking(memberID, booking) {
+			skipped = append(skipped, booking.Clone())
+			continue
+		}
+		booking.MemberID = memberID
+		linked = append(linked, booking.Clone())
+	}
+
+	sortBookings(linked)
+	sortBookings(skipped)
+	return linked, skipped
+}
+
+// holdsLiveBooking reports whether a member holds a live booking for the
+// class date of another booking. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) holdsLiveBooking(memberID string, booking *models.Booking) bool {
+	for _, id := range r.slots[slotKey(booking.ClassID, booking.Date)] {
+		existing := r.bookings[id]
+		if existing.ID != booking.ID && existing.MemberID == memberID && !existing.IsCancelled() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *InMemoryBookingRepository) Find(query BookingQuery) []*models.Booking {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	bookings := make([]*models.Booking, 0)
+	match := func(booking *models.Booking) {
+		if query.Matches(booking) {
+			bookings = append(bookings, booking.Clone())
+		}
+	}
+
+	// A single class date can be served from the index
+	if query.ClassID != "" && !query.DateFrom.IsZero() && query.DateFrom.Equal(query.DateTo) {
+		for _, id := range r.slots[slotKey(query.ClassID, query.DateFrom)] {
+			match(r.bookings[id])
+		}
+	} else {
+		for _, booking := range r.bookings {
+			match(booking)
+		}
+	}
+
+	sortBookings(bookings)
+	return bookings
+}
+
+// sortBookings orders bookings by date and then creation time
+func sortBookings(bookings []*models.Booking) {
+	sort.Slice(bookings, func(i, j int) bool {
+		if !bookings[i].Date.Equal(bookings[j].Date) {
+			return bookings[i].Date.Before(bookings[j].Date)
+		}
+		return bookings[i].CreatedAt.Before(bookings[j].CreatedAt)
+	})
+}
+
+func (r *InMemoryBookingRepository) Reschedule(id string, classID string, date time.Time, start time.Time) (*models.Booking, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	booking, exists := r.bookings[id]
+	if !exists {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status != models.BookingStatusConfirmed {
+		return nil, models.ErrBookingNotReschedulable
+	}
+	if slotKey(booking.ClassID, booking.Date) == slotKey(classID, date) {
+		return nil, models.ErrRescheduleToSameSlot
+	}
+
+	// Check the target on a copy so the original booking keeps its spot if
+	// the move is not possible
+	moved := *booking
+	moved.ClassID = classID
+	moved.Date = date
+	moved.Session = models.Session{Start: start}
+	if err := r.checkAvailability(&moved); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := *booking
+	r.unindex(booking)
+	booking.ClassID = classID
+	booking.Date = date
+	booking.Session = moved.Session
+	booking.OccurrenceID = moved.OccurrenceID
+	r.insert(booking)
+	booking.Reschedules = append(booking.Reschedules, models.Reschedule{
+		FromClassID: from.ClassID,
+		FromDate:    from.Date,
+		ToClassID:   classID,
+		ToDate:      date,
+		At:          now,
+	})
+
+	if class, err := r.classRepo.GetByID(from.ClassID); err == nil {
+		r.promoteWaitlist(class, from.Date, now)
 	}
-	return booking, nil
+	return booking.Clone(), nil
 }
 
-// GetByClassAndDate returns all bookings for a specific class on a specific date
+// GetByClassAndDate returns all active bookings for a specific class on a specific date
 func (r *InMemoryBookingRepository) GetByClassAndDate(classID string, date time.Time) []*models.Booking {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	normalizedDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	return cloneBookings(r.getByClassAndDate(classID, date))
+}
+
+// getByClassAndDate expects the caller to hold the mutex
+func (r *InMemoryBookingRepository) getByClassAndDate(classID string, date time.Time) []*models.Booking {
 	matchingBookings := make([]*models.Booking, 0)
 
-	for _, booking := range r.bookings {
-		bookingDate := time.Date(booking.Date.Year(), booking.Date.Month(), booking.Date.Day(), 0, 0, 0, 0, time.UTC)
-		if booking.ClassID == classID && bookingDate.Equal(normalizedDate) {
+	for _, id := range r.slots[slotKey(classID, date)] {
+		booking := r.bookings[id]
+		if booking.Status.IsActive() {
 			matchingBookings = append(matchingBookings, booking)
 		}
 	}
 
 	return matchingBookings
 }
+
+func (r *InMemoryBookingRepository) CountByClassAndDate(classID string, date time.Time) (int, int) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return len(r.getByClassAndDate(classID, date)), len(r.queues[slotKey(classID, date)])
+}
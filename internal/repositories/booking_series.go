@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sync"
+	"time"
+)
+
+var ErrBookingSeriesNotFound = errors.New("booking series not found")
+
+type BookingSeriesRepository interface {
+	Create(series *models.BookingSeries) error
+	Update(series *models.BookingSeries) error
+	Delete(id string) error
+	GetAll() []*models.BookingSeries
+	GetByID(id string) (*models.BookingSeries, error)
+	MarkCancelled(id string, at time.Time) (*models.BookingSeries, error)
+}
+
+type InMemoryBookingSeriesRepository struct {
+	series map[string]*models.BookingSeries
+	mutex  sync.RWMutex
+}
+
+func NewBookingSeriesRepository() BookingSeriesRepository {
+	return &InMemoryBookingSeriesRepository{
+		series: make(map[string]*models.BookingSeries),
+	}
+}
+
+func (r *InMemoryBookingSeriesRepository) Create(series *models.BookingSeries) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.series[series.ID] = series.Clone()
+	return nil
+}
+
+func (r *InMemoryBookingSeriesRepository) Update(series *models.BookingSeries) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.series[series.ID]; !exists {
+		return ErrBookingSeriesNotFound
+	}
+
+	r.series[series.ID] = series.Clone()
+	return nil
+}
+
+func (r *InMemoryBookingSeriesRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.series[id]; !exists {
+		return ErrBookingSeriesNotFound
+	}
+
+	delete(r.series, id)
+	return nil
+}
+
+func (r *InMemoryBookingSeriesRepository) GetAll() []*models.BookingSeries {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	series := make([]*models.BookingSeries, 0, len(r.series))
+	for _, s := range r.series {
+		series = append(series, s.Clone())
+	}
+	return series
+}
+
+func (r *InMemoryBookingSeriesRepository) GetByID(id string) (*models.BookingSeries, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	series, exists := r.series[id]
+	if !exists {
+		return nil, ErrBookingSeriesNotFound
+	}
+	return series.Clone(), nil
+}
+
+// MarkCancelled records when the series was cancelled
+func (r *InMemoryBookingSeriesRepository) MarkCancelled(id string, at time.Time) (*models.BookingSeries, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	series, exists := r.series[id]
+	if !exists {
+		return nil, ErrBookingSeriesNotFound
+	}
+
+	series.CancelledAt = &at
+	return series.Clone(), nil
+}
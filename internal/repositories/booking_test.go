@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepositories returns empty in-memory repositories holding one class
+// with the given capacity that runs every day of 2030
+func newTestRepositories(t *testing.T, capacity int) (ClassRepository, BookingRepository, *models.Class) {
+	t.Helper()
+
+	closures := NewClosureRepository()
+	classes := NewClassRepository(closures, NewInstructorRepository())
+	class, err := models.NewClass(models.ClassInput{
+		ClassName: "Yoga",
+		StartDate: "2030-01-01",
+		EndDate:   "2030-12-31",
+		Capacity:  capacity,
+	})
+	require.NoError(t, err)
+	require.NoError(t, classes.Create(class))
+
+	return classes, NewBookingRepository(classes, closures), class
+}
+
+func newTestBooking(t *testing.T, classID string, name string, date string) *models.Booking {
+	t.Helper()
+
+	booking, err := models.NewBooking(models.BookingInput{Name: name, ClassID: classID, Date: date})
+	require.NoError(t, err)
+	return booking
+}
+
+func TestCreate_EnforcesCapacity(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 2)
+
+	require.NoError(t, repo.Create(newTestBooking(t, class.ID, "John Doe", "2030-03-04")))
+	require.NoError(t, repo.Create(newTestBooking(t, class.ID, "Jane Roe", "2030-03-04")))
+
+	var fullErr *ClassFullError
+	require.ErrorAs(t, repo.Create(newTestBooking(t, class.ID, "Sam Poe", "2030-03-04")), &fullErr)
+	assert.Equal(t, 2, fullErr.Capacity)
+	assert.Equal(t, 2, fullErr.Booked)
+
+	// Capacity is counted per date and a cancellation frees a spot
+	require.NoError(t, repo.Create(newTestBooking(t, class.ID, "Sam Poe", "2030-03-05")))
+	booked := repo.GetByClassAndDate(class.ID, fullErr.Date)
+	_, err := repo.Cancel(booked[0].ID, &models.Cancellation{})
+	require.NoError(t, err)
+	assert.NoError(t, repo.Create(newTestBooking(t, class.ID, "Sam Poe", "2030-03-04")))
+}
+
+// Run with -race: concurrent requests must not overbook a class
+func TestCreate_Parallel(t *testing.T) {
+	const capacity, requests = 5, 50
+	_, repo, class := newTestRepositories(t, capacity)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	created, full := 0, 0
+	for i := 0; i < requests; i++ {
+		booking := newTestBooking(t, class.ID, fmt.Sprintf("Member %d", i), "2030-03-04")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := repo.Create(booking)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			var fullErr *ClassFullError
+			switch {
+			case err == nil:
+				created++
+			case errors.As(err, &fullErr):
+				full++
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, capacity, created)
+	assert.Equal(t, requests-capacity, full)
+	date, err := models.ParseDate("2030-03-04")
+	require.NoError(t, err)
+	booked, _ := repo.CountByClassAndDate(class.ID, date)
+	assert.Equal(t, capacity, booked)
+}
+
+func TestBookingRepository_ReadsReturnCopies(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 10)
+	booking := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(booking))
+
+	// Readers use what they got without the lock while the booking changes
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			if read, err := repo.GetByID(booking.ID); assert.NoError(t, err) {
+				_ = len(read.StatusHistory)
+			}
+			for _, found := range repo.Find(BookingQuery{ClassID: class.ID}) {
+				_ = found.Status
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = repo.UpdateStatus(booking.ID, models.BookingStatusAttended)
+	}()
+	wg.Wait()
+
+	read, err := repo.GetByID(booking.ID)
+	require.NoError(t, err)
+	read.Status = models.BookingStatusCancelled
+	read.StatusHistory[0].Status = models.BookingStatusCancelled
+
+	stored, err := repo.GetByID(booking.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BookingStatusAttended, stored.Status)
+	assert.Equal(t, models.BookingStatusPending, stored.StatusHistory[0].Status)
+}
+
+func TestCreate_RejectsDuplicates(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 1)
+
+	original := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(original))
+
+	// Names match ignoring case and extra whitespace
+	var duplicateErr *DuplicateBookingError
+	require.ErrorAs(t, repo.Create(newTestBooking(t, class.ID, " john   DOE ", "2030-03-04")), &duplicateErr)
+	assert.Equal(t, original.ID, duplicateErr.ExistingBookingID)
+
+	// The duplicate is reported even when the class is full and the member
+	// asks for the waitlist
+	_, err := repo.CreateOrWaitlist(newTestBooking(t, class.ID, "John Doe", "2030-03-04"))
+	assert.ErrorAs(t, err, &duplicateErr)
+
+	// Moving another booking onto the date the member holds is a duplicate too
+	other := newTestBooking(t, class.ID, "John Doe", "2030-03-05")
+	require.NoError(t, repo.Create(other))
+	_, err = repo.Reschedule(other.ID, class.ID, original.Date, time.Time{})
+	assert.ErrorAs(t, err, &duplicateErr)
+
+	// A cancelled booking does not count
+	_, err = repo.Cancel(original.ID, &models.Cancellation{})
+	require.NoError(t, err)
+	assert.NoError(t, repo.Create(newTestBooking(t, class.ID, "John Doe", "2030-03-04")))
+}
+
+func TestBookingRepository_DuplicateAcrossMemberAndName(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 10)
+
+	byName := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(byName))
+
+	byMember := newTestBooking(t, class.ID, "john  doe", "2030-03-04")
+	byMember.MemberID = "member-1"
+	var duplicateErr *DuplicateBookingError
+	require.ErrorAs(t, repo.Create(byMember), &duplicateErr)
+	assert.Equal(t, byName.ID, duplicateErr.ExistingBookingID)
+
+	// Another member with the same name is not a duplicate of a linked booking
+	linked := newTestBooking(t, class.ID, "Jane Roe", "2030-03-05")
+	linked.MemberID = "member-2"
+	require.NoError(t, repo.Create(linked))
+	namesake := newTestBooking(t, class.ID, "Jane Roe", "2030-03-05")
+	namesake.MemberID = "member-3"
+	assert.NoError(t, repo.Create(namesake))
+}
+
+func TestBookingRepository_LinkMemberSkipsDuplicates(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 10)
+
+	// A member booking made under another spelling of the name
+	byMember := newTestBooking(t, class.ID, "Jon Doe", "2030-03-04")
+	byMember.MemberID = "member-1"
+	require.NoError(t, repo.Create(byMember))
+	sameDate := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(sameDate))
+	otherDate := newTestBooking(t, class.ID, "John Doe", "2030-03-05")
+	require.NoError(t, repo.Create(otherDate))
+
+	linked, skipped := repo.LinkMember("john doe", "member-1")
+	require.Len(t, linked, 1)
+	assert.Equal(t, otherDate.ID, linked[0].ID)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, sameDate.ID, skipped[0].ID)
+
+	stored, err := repo.GetByID(sameDate.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.MemberID)
+	assert.Len(t, repo.Find(BookingQuery{MemberID: "member-1"}), 2)
+}
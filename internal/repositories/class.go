@@ -1,7 +1,6 @@
 package repositories
 
 import (
-	"errors"
 	"glofox-backend/internal/models"
 	"sync"
 	"time"
@@ -9,27 +8,167 @@ import (
 
 type ClassRepository interface {
 	Create(class *models.Class) error
+	Update(class *models.Class) error
+	Delete(id string) error
 	GetAll() []*models.Class
 	GetByID(id string) (*models.Class, error)
 	GetByDate(date time.Time) []*models.Class
+	// Find returns the classes matching every set field of the query
+	Find(query ClassQuery) []*models.Class
+	// Search returns the active classes matching every word of a full-text
+	// query, best match first
+	Search(query string, limit int) []ClassSearchResult
+	// ReindexInstructor refreshes the search index after an instructor
+	// changed
+	ReindexInstructor(instructorID string)
+}
+
+// ClassQuery filters classes, zero fields match every class
+type ClassQuery struct {
+	// Date matches classes that run on the date, leaving out archived classes
+	// and studio closures
+	Date         time.Time
+	InstructorID string
+	RoomID       string
+	// Category matches case-insensitively and Tags matches classes labelled
+	// with every tag
+	Category   string
+	Tags       []string
+	Difficulty models.ClassDifficulty
+}
+
+// IsZero reports whether the query matches every class
+func (q ClassQuery) IsZero() bool {
+	return q.Date.IsZero() && q.InstructorID == "" && q.RoomID == "" &&
+		q.Category == "" && len(q.Tags) == 0 && q.Difficulty == ""
+}
+
+// Matches reports whether a class satisfies the query, apart from studio
+// closures
+func (q ClassQuery) Matches(class *models.Class) bool {
+	if !q.Date.IsZero() && (class.IsArchived() || !class.IsDateInRange(q.Date)) {
+		return false
+	}
+	if q.InstructorID != "" && !containsString(class.InstructorIDs(), q.InstructorID) {
+		return false
+	}
+	if q.RoomID != "" && class.RoomID != q.RoomID {
+		return false
+	}
+	if q.Category != "" && class.Category != models.NormalizeLabel(q.Category) {
+		return false
+	}
+	if !class.HasTags(q.Tags) {
+		return false
+	}
+	if q.Difficulty != "" && class.Difficulty != q.Difficulty {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 type InMemoryClassRepository struct {
-	classes map[string]*models.Class
-	mutex   sync.RWMutex
+	classes     map[string]*models.Class
+	index       *classIndex
+	closures    ClosureChecker
+	instructors InstructorLookup
+	mutex       sync.RWMutex
 }
 
-func NewClassRepository() ClassRepository {
+func NewClassRepository(closures ClosureChecker, instructors InstructorLookup) ClassRepository {
 	return &InMemoryClassRepository{
-		classes: make(map[string]*models.Class),
+		classes:     make(map[string]*models.Class),
+		index:       newClassIndex(),
+		closures:    closures,
+		instructors: instructors,
 	}
 }
 
+// Create stores a class unless its room or one of its instructors is already
+// used by another class at the same time
 func (r *InMemoryClassRepository) Create(class *models.Class) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	if err := r.checkSchedule(class); err != nil {
+		return err
+	}
+
+	r.classes[class.ID] = class
+	r.index.add(class, r.instructorNames(class))
+	return nil
+}
+
+// Update replaces a stored class with a new version unless its room or one of
+// its instructors is already used by another class at the same time
+func (r *InMemoryClassRepository) Update(class *models.Class) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.classes[class.ID]; !exists {
+		return ErrClassNotFound
+	}
+	if err := r.checkSchedule(class); err != nil {
+		return err
+	}
+
 	r.classes[class.ID] = class
+	r.index.add(class, r.instructorNames(class))
+	return nil
+}
+
+// checkSchedule returns a *RoomConflictError when another class uses the room
+// of the class at an overlapping time, or an *InstructorConflictError when an
+// instructor of the class is assigned to an overlapping session of another
+// class. It expects the caller to hold the mutex.
+func (r *InMemoryClassRepository) checkSchedule(class *models.Class) error {
+	if class.IsArchived() {
+		return nil
+	}
+
+	if class.RoomID != "" {
+		for _, other := range r.classes {
+			if other.ID == class.ID || other.IsArchived() {
+				continue
+			}
+			if date, overlaps := class.RoomOverlap(other); overlaps {
+				return &RoomConflictError{RoomID: class.RoomID, ClassID: other.ID, Date: date}
+			}
+		}
+	}
+
+	for _, instructorID := range class.InstructorIDs() {
+		for _, other := range r.classes {
+			if other.ID == class.ID || other.IsArchived() || !containsString(other.InstructorIDs(), instructorID) {
+				continue
+			}
+			if date, overlaps := class.InstructorOverlap(other, instructorID); overlaps {
+				return &InstructorConflictError{InstructorID: instructorID, ClassID: other.ID, Date: date}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryClassRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.classes[id]; !exists {
+		return ErrClassNotFound
+	}
+
+	delete(r.classes, id)
+	r.index.remove(id)
 	return nil
 }
 
@@ -50,21 +189,42 @@ func (r *InMemoryClassRepository) GetByID(id string) (*models.Class, error) {
 
 	class, exists := r.classes[id]
 	if !exists {
-		return nil, errors.New("class not found")
+		return nil, ErrClassNotFound
 	}
 	return class, nil
 }
 
-// GetByDate returns all classes available on a given date
+// GetByDate returns all classes available on a given date, leaving out
+// archived classes. No class is available while the studio is closed.
 func (r *InMemoryClassRepository) GetByDate(date time.Time) []*models.Class {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	matchingClasses := make([]*models.Class, 0)
+	if r.closures.ClosureOn(date) != nil {
+		return matchingClasses
+	}
 	for _, class := range r.classes {
-		if class.IsDateInRange(date) {
+		if !class.IsArchived() && class.IsDateInRange(date) {
 			matchingClasses = append(matchingClasses, class)
 		}
 	}
 	return matchingClasses
 }
+
+func (r *InMemoryClassRepository) Find(query ClassQuery) []*models.Class {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	classes := make([]*models.Class, 0)
+	if !query.Date.IsZero() && r.closures.ClosureOn(query.Date) != nil {
+		return classes
+	}
+
+	for _, class := range r.classes {
+		if query.Matches(class) {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
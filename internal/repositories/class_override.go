@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"glofox-backend/internal/models"
+	"time"
+)
+
+func (r *InMemoryBookingRepository) SetClassOverride(classID string, date time.Time, override *models.ClassOverride, options models.ClassChangeOptions) ([]*models.Booking, []models.BookingConflict, []models.OverbookingReport, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.setClassOverride(classID, date, override, options)
+}
+
+func (r *InMemoryBookingRepository) CancelUnderAttended(classID string, date time.Time, cancellation models.Cancellation) (int, bool, []*models.Booking, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	class, err := r.classRepo.GetByID(classID)
+	if err != nil {
+		return 0, false, nil, ErrClassNotFound
+	}
+
+	booked := len(r.getByClassAndDate(classID, date))
+	if !class.HasMinAttendance() || class.IsCancelledOn(date) || booked >= class.MinAttendance {
+		return booked, false, nil, nil
+	}
+
+	// Keep any other change made to the occurrence
+	override, exists := class.OverrideOn(date)
+	if !exists {
+		override = models.ClassOverride{Date: models.DateOf(date)}
+	}
+	override.Cancelled = true
+	override.Reason = cancellation.Reason
+	override.UpdatedAt = cancellation.CancelledAt
+
+	cancelled, _, _, err := r.setClassOverride(classID, date, &override, models.ClassChangeOptions{Cancellation: cancellation})
+	if err != nil {
+		return booked, false, nil, err
+	}
+	return booked, true, cancelled, nil
+}
+
+func (r *InMemoryBookingRepository) setClassOverride(classID string, date time.Time, override *models.ClassOverride, options models.ClassChangeOptions) ([]*models.Booking, []models.BookingConflict, []models.OverbookingReport, error) {
+	class, err := r.classRepo.GetByID(classID)
+	if err != nil {
+		return nil, nil, nil, ErrClassNotFound
+	}
+	if !class.IsScheduledOn(date) {
+		return nil, nil, nil, ErrDateOutOfRange
+	}
+
+	updated := class.WithOverride(date, override)
+	conflicts := make([]models.BookingConflict, 0)
+	booked := r.getByClassAndDate(classID, date)
+	capacity := updated.CapacityOn(date)
+	overbooked := !updated.IsCancelledOn(date) && len(booked) > capacity
+	if overbooked && !options.ResolvesOverbooking() {
+		conflict := models.BookingConflict{
+			Date:     date,
+			Reason:   models.ConflictOverCapacity,
+			Booked:   len(booked),
+			Capacity: capacity,
+		}
+		for _, booking := range booked {
+			conflict.BookingIDs = append(conflict.BookingIDs, booking.ID)
+		}
+		conflicts = append(conflicts, conflict)
+
+		if !options.Force {
+			return nil, conflicts, nil, &ClassUpdateConflictError{Conflicts: conflicts}
+		}
+	}
+
+	if err := r.classRepo.Update(updated); err != nil {
+		return nil, nil, nil, err
+	}
+
+	key := slotKey(classID, date)
+	cancelled := make([]*models.Booking, 0)
+	if updated.IsCancelledOn(date) {
+		for _, id := range r.slots[key] {
+			booking := r.bookings[id]
+			if !booking.Status.CanTransitionTo(models.BookingStatusCancelled) {
+				continue
+			}
+			if err := r.cancelLocked(booking, options.Cancellation); err != nil {
+				return nil, nil, nil, err
+			}
+			cancelled = append(cancelled, booking.Clone())
+		}
+		return cancelled, conflicts, []models.OverbookingReport{}, nil
+	}
+
+	now := time.Now()
+	reports := make([]models.OverbookingReport, 0, 1)
+	if overbooked && options.ResolvesOverbooking() {
+		report, err := r.resolveOverbooking(updated, date, options, now)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	session := updated.SessionOn(date)
+	for _, id := range r.slots[key] {
+		if booking := r.bookings[id]; !booking.IsCancelled() {
+			booking.Session = session
+		}
+	}
+
+	r.promoteWaitlist(updated, date, now)
+	return cancelled, conflicts, reports, nil
+}
@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"glofox-backend/internal/models"
+)
+
+// Weights of the class fields in search scores, a match in the class name
+// ranks above one in its description
+const (
+	searchWeightName        = 4
+	searchWeightTag         = 3
+	searchWeightCategory    = 3
+	searchWeightInstructor  = 2
+	searchWeightDescription = 1
+)
+
+// ClassSearchResult is a class matching a search with its relevance score
+type ClassSearchResult struct {
+	Class *models.Class `json:"class"`
+	Score float64       `json:"score"`
+}
+
+// classIndex is an inverted index from search tokens to the classes holding
+// them, weighted by the field the token appears in
+type classIndex struct {
+	postings map[string]map[string]int
+	// vocabulary holds the indexed tokens in sorted order, so the tokens
+	// starting with a prefix can be found by binary search
+	vocabulary []string
+	// tokens lists the tokens indexed for each class so it can be removed
+	tokens map[string][]string
+}
+
+func newClassIndex() *classIndex {
+	return &classIndex{
+		postings: make(map[string]map[string]int),
+		tokens:   make(map[string][]string),
+	}
+}
+
+// tokenize splits text into lowercase words of letters and digits
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// add indexes a class, replacing what was indexed for it before
+func (idx *classIndex) add(class *models.Class, instructorNames []string) {
+	idx.remove(class.ID)
+
+	weights := make(map[string]int)
+	addText := func(text string, weight int) {
+		for _, token := range tokenize(text) {
+			if weight > weights[token] {
+				weights[token] = weight
+			}
+		}
+	}
+	addText(class.ClassName, searchWeightName)
+	addText(strings.Join(class.Tags, " "), searchWeightTag)
+	addText(class.Category, searchWeightCategory)
+	addText(strings.Join(instructorNames, " "), searchWeightInstructor)
+	addText(class.Description, searchWeightDescription)
+
+	tokens := make([]string, 0, len(weights))
+	for token, weight := range weights {
+		if idx.postings[token] == nil {
+			idx.postings[token] = make(map[string]int)
+			i := sort.SearchStrings(idx.vocabulary, token)
+			idx.vocabulary = append(idx.vocabulary, "")
+			copy(idx.vocabulary[i+1:], idx.vocabulary[i:])
+			idx.vocabulary[i] = token
+		}
+		idx.postings[token][class.ID] = weight
+		tokens = append(tokens, token)
+	}
+	idx.tokens[class.ID] = tokens
+}
+
+// remove drops a class from the index
+func (idx *classIndex) remove(classID string) {
+	for _, token := range idx.tokens[classID] {
+		delete(idx.postings[token], classID)
+		if len(idx.postings[token]) == 0 {
+			delete(idx.postings, token)
+			i := sort.SearchStrings(idx.vocabulary, token)
+			idx.vocabulary = append(idx.vocabulary[:i], idx.vocabulary[i+1:]...)
+		}
+	}
+	delete(idx.tokens, classID)
+}
+
+// search scores the classes matching every query token. A query token matches
+// an indexed token equal to it, or at half the weight one it is a prefix of,
+// so partly typed words still find classes.
+func (idx *classIndex) search(query string) map[string]float64 {
+	var scores map[string]float64
+	for _, queryToken := range tokenize(query) {
+		tokenScores := make(map[string]float64)
+		// The tokens starting with the query token sort right from it
+		for i := sort.SearchStrings(idx.vocabulary, queryToken); i < len(idx.vocabulary); i++ {
+			token := idx.vocabulary[i]
+			if !strings.HasPrefix(token, queryToken) {
+				break
+			}
+			factor := 0.5
+			if token == queryToken {
+				factor = 1
+			}
+			for classID, weight := range idx.postings[token] {
+				if score := factor * float64(weight); score > tokenScores[classID] {
+					tokenScores[classID] = score
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = tokenScores
+			continue
+		}
+		for classID, score := range scores {
+			if tokenScore, found := tokenScores[classID]; found {
+				scores[classID] = score + tokenScore
+			} else {
+				delete(scores, classID)
+			}
+		}
+	}
+	return scores
+}
+
+// instructorNames returns the names of the instructors of a class. It is safe
+// to call with the class mutex held.
+func (r *InMemoryClassRepository) instructorNames(class *models.Class) []string {
+	names := make([]string, 0)
+	for _, id := range class.InstructorIDs() {
+		if instructor, err := r.instructors.GetByID(id); err == nil {
+			names = append(names, instructor.Name)
+		}
+	}
+	return names
+}
+
+// Search returns the active classes matching every word of the query, best
+// match first, up to limit results when limit is positive
+func (r *InMemoryClassRepository) Search(query string, limit int) []ClassSearchResult {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	results := make([]ClassSearchResult, 0)
+	for classID, score := range r.index.search(query) {
+		class := r.classes[classID]
+		if class == nil || class.IsArchived() {
+			continue
+		}
+		results = append(results, ClassSearchResult{Class: class, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Class.ClassName < results[j].Class.ClassName
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// ReindexInstructor refreshes the search index of the classes an instructor
+// teaches, after the instructor was renamed
+func (r *InMemoryClassRepository) ReindexInstructor(instructorID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, class := range r.classes {
+		if containsString(class.InstructorIDs(), instructorID) {
+			r.index.add(class, r.instructorNames(class))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"testing"
+
+	"glofox-backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchNames(repo ClassRepository, query string) []string {
+	names := make([]string, 0)
+	for _, result := range repo.Search(query, 0) {
+		names = append(names, result.Class.ClassName)
+	}
+	return names
+}
+
+func TestClassSearch(t *testing.T) {
+	repo := NewClassRepository(NewClosureRepository(), NewInstructorRepository())
+	create := func(input models.ClassInput) *models.Class {
+		input.StartDate, input.EndDate, input.Capacity = "2030-01-01", "2030-12-31", 10
+		class, err := models.NewClass(input)
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(class))
+		return class
+	}
+	create(models.ClassInput{ClassName: "Morning Yoga", Category: "yoga"})
+	create(models.ClassInput{ClassName: "Spin", Description: "A yoga cool-down after the ride"})
+	create(models.ClassInput{ClassName: "Yogalates", Tags: []string{"core"}})
+	boxing := create(models.ClassInput{ClassName: "Boxing", Tags: []string{"cardio"}})
+
+	// Name matches rank above description matches, even as prefix matches
+	// at half weight
+	assert.Equal(t, []string{"Morning Yoga", "Yogalates", "Spin"}, searchNames(repo, "yoga"))
+	assert.Equal(t, []string{"Morning Yoga", "Yogalates", "Spin"}, searchNames(repo, "YOG"))
+	// Every query word must match
+	assert.Equal(t, []string{"Morning Yoga"}, searchNames(repo, "morn yoga"))
+	assert.Empty(t, searchNames(repo, "yoga cardio"))
+	assert.Empty(t, searchNames(repo, "zumba"))
+
+	// Tokens of a changed or deleted class stop matching
+	updated, err := boxing.Updated(models.ClassInput{
+		ClassName: "Kickboxing",
+		StartDate: "2030-01-01",
+		EndDate:   "2030-12-31",
+		Capacity:  10,
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.Update(updated))
+	assert.Empty(t, searchNames(repo, "cardio"))
+	assert.Equal(t, []string{"Kickboxing"}, searchNames(repo, "kick"))
+
+	require.NoError(t, repo.Delete(boxing.ID))
+	assert.Empty(t, searchNames(repo, "kick"))
+}
+
+func TestClassIndex_Vocabulary(t *testing.T) {
+	idx := newClassIndex()
+	idx.add(&models.Class{ID: "a", ClassName: "Yoga Flow"}, nil)
+	idx.add(&models.Class{ID: "b", ClassName: "Power Yoga"}, nil)
+	assert.Equal(t, []string{"flow", "power", "yoga"}, idx.vocabulary)
+
+	idx.remove("a")
+	assert.Equal(t, []string{"power", "yoga"}, idx.vocabulary)
+	idx.add(&models.Class{ID: "b", ClassName: "Yin"}, nil)
+	assert.Equal(t, []string{"yin"}, idx.vocabulary)
+}
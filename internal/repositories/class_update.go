@@ -0,0 +1,235 @@
+package repositories
+
+import (
+	"glofox-backend/internal/models"
+	"sort"
+	"strings"
+	"time"
+)
+
+func (r *InMemoryBookingRepository) ApplyClassUpdate(classID string, update func(*models.Class) (*models.Class, error), options models.ClassChangeOptions) (*models.Class, []models.BookingConflict, []models.OverbookingReport, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	current, err := r.classRepo.GetByID(classID)
+	if err != nil {
+		return nil, nil, nil, ErrClassNotFound
+	}
+	class, err := update(current)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	now := time.Now()
+	today := models.DateOf(now)
+
+	conflicts := make([]models.BookingConflict, 0)
+	overbooked := make([]time.Time, 0)
+	for _, date := range r.upcomingDates(class.ID, today) {
+		booked := r.getByClassAndDate(class.ID, date)
+		conflict := models.BookingConflict{
+			Date:     date,
+			Booked:   len(booked),
+			Capacity: class.CapacityOn(date),
+		}
+
+		switch {
+		case !class.IsDateInRange(date):
+			// Members waiting for a date the class no longer runs on are
+			// stranded too
+			conflict.Reason = models.ConflictOutOfRange
+			booked = append(booked, r.waitlistedBookings(class.ID, date)...)
+		case len(booked) > conflict.Capacity && options.ResolvesOverbooking():
+			overbooked = append(overbooked, date)
+			continue
+		case len(booked) > conflict.Capacity:
+			conflict.Reason = models.ConflictOverCapacity
+		default:
+			continue
+		}
+
+		for _, booking := range booked {
+			conflict.BookingIDs = append(conflict.BookingIDs, booking.ID)
+		}
+		conflicts = append(conflicts, conflict)
+	}
+
+	if len(conflicts) > 0 && !options.Force {
+		return nil, conflicts, nil, &ClassUpdateConflictError{Conflicts: conflicts}
+	}
+
+	if err := r.classRepo.Update(class); err != nil {
+		return nil, nil, nil, err
+	}
+
+	reports := make([]models.OverbookingReport, 0, len(overbooked))
+	for _, date := range overbooked {
+		report, err := r.resolveOverbooking(class, date, options, now)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	// Keep upcoming bookings on the session times of the updated class
+	upcoming := r.classBookings(class.ID, func(booking *models.Booking) bool {
+		return !booking.Date.Before(today)
+	})
+	for _, booking := range upcoming {
+		if !booking.IsCancelled() {
+			booking.Session = class.SessionOn(booking.Date)
+		}
+	}
+
+	// A forced update cancels the waitlist of the dates it removes, as no
+	// spot can open up on them
+	for _, date := range r.waitlistedDates(class.ID) {
+		if class.IsDateInRange(date) {
+			r.promoteWaitlist(class, date, now)
+			continue
+		}
+		if date.Before(today) {
+			continue
+		}
+		for _, booking := range r.waitlistedBookings(class.ID, date) {
+			if err := r.cancelLocked(booking, options.Cancellation); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+	return class, conflicts, reports, nil
+}
+
+// bookedDates returns the dates a class has active bookings on, in order. It
+// expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) bookedDates(classID string) []time.Time {
+	dates := make([]time.Time, 0)
+	for key, ids := range r.slots {
+		if !strings.HasPrefix(key, classID+"|") {
+			continue
+		}
+		for _, id := range ids {
+			if booking := r.bookings[id]; booking.Status.IsActive() {
+				dates = append(dates, booking.Date)
+				break
+			}
+		}
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dates
+}
+
+// upcomingDates returns the dates from today on that a class has active
+// bookings or members waiting for, in order. It expects the caller to hold
+// the mutex.
+func (r *InMemoryBookingRepository) upcomingDates(classID string, today time.Time) []time.Time {
+	dates := make([]time.Time, 0)
+	seen := make(map[string]bool)
+	for _, date := range append(r.bookedDates(classID), r.waitlistedDates(classID)...) {
+		key := slotKey(classID, date)
+		if date.Before(today) || seen[key] {
+			continue
+		}
+		seen[key] = true
+		dates = append(dates, date)
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dates
+}
+
+// waitlistedBookings returns the bookings waiting for a class date, in queue
+// order. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) waitlistedBookings(classID string, date time.Time) []*models.Booking {
+	queue := r.queues[slotKey(classID, date)]
+	bookings := make([]*models.Booking, 0, len(queue))
+	for _, id := range queue {
+		if booking, exists := r.bookings[r.waitlist[id].BookingID]; exists {
+			bookings = append(bookings, booking)
+		}
+	}
+	return bookings
+}
+
+// waitlistedDates returns the dates a class has members waiting for. It
+// expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) waitlistedDates(classID string) []time.Time {
+	dates := make([]time.Time, 0)
+	for key, queue := range r.queues {
+		if strings.HasPrefix(key, classID+"|") && len(queue) > 0 {
+			dates = append(dates, r.waitlist[queue[0]].Date)
+		}
+	}
+	return dates
+}
+
+func (r *InMemoryBookingRepository) RemoveClass(classID string, mode models.ClassRemovalMode, cancellation models.Cancellation) ([]*models.Booking, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	class, err := r.classRepo.GetByID(classID)
+	if err != nil {
+		return nil, ErrClassNotFound
+	}
+
+	if mode == models.ClassRemovalReject {
+		existing := r.classBookings(classID, func(booking *models.Booking) bool {
+			return !booking.IsCancelled()
+		})
+		if len(existing) > 0 {
+			return nil, &ClassHasBookingsError{Bookings: cloneBookings(existing)}
+		}
+		return []*models.Booking{}, r.classRepo.Delete(classID)
+	}
+
+	at := cancellation.CancelledAt
+	today := models.DateOf(at)
+	affected := r.classBookings(classID, func(booking *models.Booking) bool {
+		return !booking.Date.Before(today) && booking.Status.CanTransitionTo(models.BookingStatusCancelled)
+	})
+
+	// The class is removed first so the bookings stay as they are if that
+	// fails
+	if mode == models.ClassRemovalArchive {
+		archived := *class
+		archived.ArchivedAt = &at
+		err = r.classRepo.Update(&archived)
+	} else {
+		err = r.classRepo.Delete(classID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, booking := range affected {
+		if err := r.cancelLocked(booking, cancellation); err != nil {
+			return nil, err
+		}
+	}
+	return cloneBookings(affected), nil
+}
+
+// classBookings returns the bookings of a class that satisfy match, in date
+// order. It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) classBookings(classID string, match func(*models.Booking) bool) []*models.Booking {
+	bookings := make([]*models.Booking, 0)
+	for key, ids := range r.slots {
+		if !strings.HasPrefix(key, classID+"|") {
+			continue
+		}
+		for _, id := range ids {
+			if booking := r.bookings[id]; match(booking) {
+				bookings = append(bookings, booking)
+			}
+		}
+	}
+
+	sort.Slice(bookings, func(i, j int) bool {
+		if !bookings[i].Date.Equal(bookings[j].Date) {
+			return bookings[i].Date.Before(bookings[j].Date)
+		}
+		return bookings[i].CreatedAt.Before(bookings[j].CreatedAt)
+	})
+	return bookings
+}
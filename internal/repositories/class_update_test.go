@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyClassUpdate_KeepsConcurrentOverrides(t *testing.T) {
+	classes, repo, class := newTestRepositories(t, 10)
+	start := time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for day := 0; day < 20; day++ {
+			date := start.AddDate(0, 0, day)
+			override := models.ClassOverride{Date: date, Cancelled: true}
+			_, _, _, err := repo.SetClassOverride(class.ID, date, &override, models.ClassChangeOptions{})
+			assert.NoError(t, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for capacity := 11; capacity < 31; capacity++ {
+			patch := models.ClassPatchInput{Capacity: &capacity}
+			_, _, _, err := repo.ApplyClassUpdate(class.ID, func(current *models.Class) (*models.Class, error) {
+				return current.Updated(patch.Apply(current.ToInput()))
+			}, models.ClassChangeOptions{})
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+
+	stored, err := classes.GetByID(class.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, stored.Capacity)
+	for day := 0; day < 20; day++ {
+		assert.True(t, stored.IsCancelledOn(start.AddDate(0, 0, day)), "override of day %d was lost", day)
+	}
+}
+
+func TestApplyClassUpdate_ClassNotFound(t *testing.T) {
+	_, repo, _ := newTestRepositories(t, 10)
+
+	_, _, _, err := repo.ApplyClassUpdate("missing", func(current *models.Class) (*models.Class, error) {
+		return current, nil
+	}, models.ClassChangeOptions{})
+	assert.ErrorIs(t, err, ErrClassNotFound)
+}
+
+func TestCancelUnderAttended(t *testing.T) {
+	closures := NewClosureRepository()
+	classes := NewClassRepository(closures, NewInstructorRepository())
+	repo := NewBookingRepository(classes, closures)
+	class, err := models.NewClass(models.ClassInput{
+		ClassName:     "Yoga",
+		StartDate:     "2030-01-01",
+		EndDate:       "2030-12-31",
+		Capacity:      10,
+		MinAttendance: 2,
+		CutoffMinutes: 60,
+	})
+	require.NoError(t, err)
+	require.NoError(t, classes.Create(class))
+
+	booking := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(booking))
+	full := newTestBooking(t, class.ID, "John Doe", "2030-03-05")
+	require.NoError(t, repo.Create(full))
+	require.NoError(t, repo.Create(newTestBooking(t, class.ID, "Jane Roe", "2030-03-05")))
+
+	// An override set after the caller read the class is kept
+	override := models.ClassOverride{Date: booking.Date, Capacity: 5, Reason: "smaller room"}
+	_, _, _, err = repo.SetClassOverride(class.ID, booking.Date, &override, models.ClassChangeOptions{})
+	require.NoError(t, err)
+
+	cancellation := models.Cancellation{CancelledBy: "system", Reason: "too few bookings", CancelledAt: time.Now()}
+	booked, cancelled, bookings, err := repo.CancelUnderAttended(class.ID, booking.Date, cancellation)
+	require.NoError(t, err)
+	assert.Equal(t, 1, booked)
+	assert.True(t, cancelled)
+	require.Len(t, bookings, 1)
+	assert.Equal(t, booking.ID, bookings[0].ID)
+	assert.Equal(t, models.BookingStatusCancelled, bookings[0].Status)
+
+	stored, err := classes.GetByID(class.ID)
+	require.NoError(t, err)
+	kept, exists := stored.OverrideOn(booking.Date)
+	require.True(t, exists)
+	assert.True(t, kept.Cancelled)
+	assert.Equal(t, 5, kept.Capacity)
+	assert.Equal(t, "too few bookings", kept.Reason)
+
+	// A date at the minimum is left alone
+	booked, cancelled, _, err = repo.CancelUnderAttended(class.ID, full.Date, cancellation)
+	require.NoError(t, err)
+	assert.Equal(t, 2, booked)
+	assert.False(t, cancelled)
+	assertStatus(t, repo, full.ID, models.BookingStatusConfirmed)
+}
+
+func TestApplyClassUpdate_WaitlistOnRemovedDates(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 1)
+	booked := newTestBooking(t, class.ID, "John Doe", "2030-12-20")
+	require.NoError(t, repo.Create(booked))
+	waiting := newTestBooking(t, class.ID, "Jane Roe", "2030-12-20")
+	entry, err := repo.CreateOrWaitlist(waiting)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	endDate := "2030-12-10"
+	shorten := func(current *models.Class) (*models.Class, error) {
+		patch := models.ClassPatchInput{EndDate: &endDate}
+		return current.Updated(patch.Apply(current.ToInput()))
+	}
+
+	// The waiting member is reported with the booking they wait behind
+	_, conflicts, _, err := repo.ApplyClassUpdate(class.ID, shorten, models.ClassChangeOptions{})
+	var conflictErr *ClassUpdateConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, models.ConflictOutOfRange, conflicts[0].Reason)
+	assert.Equal(t, 1, conflicts[0].Booked)
+	assert.Equal(t, []string{booked.ID, waiting.ID}, conflicts[0].BookingIDs)
+
+	// Forcing the update cancels the waitlist, no spot opens up on the date
+	_, _, _, err = repo.ApplyClassUpdate(class.ID, shorten, models.ClassChangeOptions{
+		Force:        true,
+		Cancellation: models.Cancellation{Reason: "schedule change", CancelledAt: time.Now()},
+	})
+	require.NoError(t, err)
+	assertStatus(t, repo, booked.ID, models.BookingStatusConfirmed)
+	assertStatus(t, repo, waiting.ID, models.BookingStatusCancelled)
+	assert.Empty(t, repo.GetWaitlist(class.ID, waiting.Date))
+}
+
+func TestApplyClassUpdate_KeepsSessionOfCancelledBookings(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 10)
+	booking := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(booking))
+	cancelled, err := repo.Cancel(booking.ID, &models.Cancellation{CancelledAt: time.Now()})
+	require.NoError(t, err)
+
+	startTime, duration := "18:00", 60
+	_, _, _, err = repo.ApplyClassUpdate(class.ID, func(current *models.Class) (*models.Class, error) {
+		patch := models.ClassPatchInput{StartTime: &startTime, DurationMinutes: &duration}
+		return current.Updated(patch.Apply(current.ToInput()))
+	}, models.ClassChangeOptions{})
+	require.NoError(t, err)
+
+	stored, err := repo.GetByID(booking.ID)
+	require.NoError(t, err)
+	assert.Equal(t, cancelled.Session, stored.Session)
+}
+
+// failingClassRepository fails to store any change to a class
+type failingClassRepository struct {
+	ClassRepository
+	err error
+}
+
+func (r failingClassRepository) Update(*models.Class) error { return r.err }
+
+func (r failingClassRepository) Delete(string) error { return r.err }
+
+func TestRemoveClass_KeepsBookingsWhenStoreFails(t *testing.T) {
+	classes, _, class := newTestRepositories(t, 10)
+	storeErr := errors.New("store unavailable")
+	failing := NewBookingRepository(failingClassRepository{ClassRepository: classes, err: storeErr}, NewClosureRepository())
+	booking := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, failing.Create(booking))
+
+	for _, mode := range []models.ClassRemovalMode{models.ClassRemovalCascade, models.ClassRemovalArchive} {
+		_, err := failing.RemoveClass(class.ID, mode, models.Cancellation{CancelledAt: time.Now()})
+		assert.ErrorIs(t, err, storeErr)
+		assertStatus(t, failing, booking.ID, models.BookingStatusConfirmed)
+	}
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrClosureNotFound = errors.New("closure not found")
+
+// ClosureChecker tells whether the studio is closed on a date
+type ClosureChecker interface {
+	// ClosureOn returns the closure covering the date, or nil when the studio
+	// is open
+	ClosureOn(date time.Time) *models.Closure
+}
+
+type ClosureRepository interface {
+	ClosureChecker
+	Create(closure *models.Closure) error
+	// GetAll returns all closures ordered by start date
+	GetAll() []*models.Closure
+	GetByID(id string) (*models.Closure, error)
+	Delete(id string) error
+}
+
+type InMemoryClosureRepository struct {
+	closures map[string]*models.Closure
+	mutex    sync.RWMutex
+}
+
+func NewClosureRepository() ClosureRepository {
+	return &InMemoryClosureRepository{
+		closures: make(map[string]*models.Closure),
+	}
+}
+
+func (r *InMemoryClosureRepository) Create(closure *models.Closure) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.closures[closure.ID] = closure
+	return nil
+}
+
+func (r *InMemoryClosureRepository) GetAll() []*models.Closure {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	closures := make([]*models.Closure, 0, len(r.closures))
+	for _, closure := range r.closures {
+		closures = append(closures, closure)
+	}
+	sort.Slice(closures, func(i, j int) bool {
+		return closures[i].StartDate.Before(closures[j].StartDate)
+	})
+	return closures
+}
+
+func (r *InMemoryClosureRepository) GetByID(id string) (*models.Closure, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	closure, exists := r.closures[id]
+	if !exists {
+		return nil, ErrClosureNotFound
+	}
+	return closure, nil
+}
+
+func (r *InMemoryClosureRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.closures[id]; !exists {
+		return ErrClosureNotFound
+	}
+
+	delete(r.closures, id)
+	return nil
+}
+
+func (r *InMemoryClosureRepository) ClosureOn(date time.Time) *models.Closure {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, closure := range r.closures {
+		if closure.Covers(date) {
+			return closure
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryBookingRepository) CancelByDateRange(from, to time.Time, cancellation models.Cancellation) []*models.Booking {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	cancelled := make([]*models.Booking, 0)
+	for _, booking := range r.bookings {
+		if booking.Date.Before(from) || booking.Date.After(to) {
+			continue
+		}
+		if err := r.cancelLocked(booking, cancellation); err != nil {
+			continue
+		}
+		cancelled = append(cancelled, booking.Clone())
+	}
+
+	sort.Slice(cancelled, func(i, j int) bool {
+		if !cancelled[i].Date.Equal(cancelled[j].Date) {
+			return cancelled[i].Date.Before(cancelled[j].Date)
+		}
+		return cancelled[i].CreatedAt.Before(cancelled[j].CreatedAt)
+	})
+	return cancelled
+}
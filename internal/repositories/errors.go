@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"glofox-backend/internal/models"
+	"time"
+)
+
+var (
+	ErrClassNotFound   = errors.New("class not found")
+	ErrBookingNotFound = errors.New("booking not found")
+	ErrDateOutOfRange  = errors.New("no class available on the requested date")
+	ErrNoSessionAtTime = errors.New("class has no session starting at the requested time")
+	ErrClassArchived   = errors.New("class is archived and no longer takes bookings")
+)
+
+// ClassFullError is returned when a booking would exceed the capacity of a
+// class on a given date
+type ClassFullError struct {
+	ClassID  string
+	Date     time.Time
+	Capacity int
+	Booked   int
+}
+
+func (e *ClassFullError) Error() string {
+	return fmt.Sprintf("class is full on %s (%d/%d booked)", e.Date.Format("2006-01-02"), e.Booked, e.Capacity)
+}
+
+// RemainingSpots returns how many spots are still free, never less than zero
+func (e *ClassFullError) RemainingSpots() int {
+	if e.Booked >= e.Capacity {
+		return 0
+	}
+	return e.Capacity - e.Booked
+}
+
+// DuplicateBookingError is returned when a member already holds a booking for
+// the same class on the same date
+type DuplicateBookingError struct {
+	ExistingBookingID string
+}
+
+func (e *DuplicateBookingError) Error() string {
+	return "member already has a booking for this class on this date"
+}
+
+// ClassUpdateConflictError is returned when a class update would strand
+// existing bookings
+type ClassUpdateConflictError struct {
+	Conflicts []models.BookingConflict
+}
+
+func (e *ClassUpdateConflictError) Error() string {
+	return fmt.Sprintf("class update would strand bookings on %d date(s)", len(e.Conflicts))
+}
+
+// ClassHasBookingsError is returned when a class cannot be deleted because it
+// still has bookings
+type ClassHasBookingsError struct {
+	Bookings []*models.Booking
+}
+
+func (e *ClassHasBookingsError) Error() string {
+	return fmt.Sprintf("class has %d booking(s)", len(e.Bookings))
+}
+
+// StudioClosedError is returned when a booking falls on a day the studio is
+// closed
+type StudioClosedError struct {
+	Closure *models.Closure
+}
+
+func (e *StudioClosedError) Error() string {
+	return "studio is closed on the requested date: " + e.Closure.Reason
+}
+
+// InstructorConflictError is returned when an instructor would teach two
+// classes at the same time
+type InstructorConflictError struct {
+	InstructorID string
+	ClassID      string
+	Date         time.Time
+}
+
+func (e *InstructorConflictError) Error() string {
+	return fmt.Sprintf("instructor already teaches class %s at the same time on %s", e.ClassID, e.Date.Format("2006-01-02"))
+}
+
+// RoomConflictError is returned when two classes would use the same room at
+// the same time
+type RoomConflictError struct {
+	RoomID  string
+	ClassID string
+	Date    time.Time
+}
+
+func (e *RoomConflictError) Error() string {
+	return fmt.Sprintf("room is already used by class %s at the same time on %s", e.ClassID, e.Date.Format("2006-01-02"))
+}
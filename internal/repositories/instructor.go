@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sort"
+	"sync"
+)
+
+var ErrInstructorNotFound = errors.New("instructor not found")
+
+// InstructorLookup resolves instructors by ID, e.g. to search classes by the
+// names of their instructors
+type InstructorLookup interface {
+	GetByID(id string) (*models.Instructor, error)
+}
+
+type InstructorRepository interface {
+	Create(instructor *models.Instructor) error
+	Update(instructor *models.Instructor) error
+	Delete(id string) error
+	// GetAll returns all instructors ordered by name
+	GetAll() []*models.Instructor
+	GetByID(id string) (*models.Instructor, error)
+}
+
+type InMemoryInstructorRepository struct {
+	instructors map[string]*models.Instructor
+	mutex       sync.RWMutex
+}
+
+func NewInstructorRepository() InstructorRepository {
+	return &InMemoryInstructorRepository{
+		instructors: make(map[string]*models.Instructor),
+	}
+}
+
+func (r *InMemoryInstructorRepository) Create(instructor *models.Instructor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.instructors[instructor.ID] = instructor
+	return nil
+}
+
+func (r *InMemoryInstructorRepository) Update(instructor *models.Instructor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.instructors[instructor.ID]; !exists {
+		return ErrInstructorNotFound
+	}
+
+	r.instructors[instructor.ID] = instructor
+	return nil
+}
+
+func (r *InMemoryInstructorRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.instructors[id]; !exists {
+		return ErrInstructorNotFound
+	}
+
+	delete(r.instructors, id)
+	return nil
+}
+
+func (r *InMemoryInstructorRepository) GetAll() []*models.Instructor {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	instructors := make([]*models.Instructor, 0, len(r.instructors))
+	for _, instructor := range r.instructors {
+		instructors = append(instructors, instructor)
+	}
+	sort.Slice(instructors, func(i, j int) bool {
+		return instructors[i].Name < instructors[j].Name
+	})
+	return instructors
+}
+
+func (r *InMemoryInstructorRepository) GetByID(id string) (*models.Instructor, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	instructor, exists := r.instructors[id]
+	if !exists {
+		return nil, ErrInstructorNotFound
+	}
+	return instructor, nil
+}
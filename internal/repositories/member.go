@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sort"
+	"sync"
+)
+
+var ErrMemberNotFound = errors.New("member not found")
+
+type MemberRepository interface {
+	Create(member *models.Member) error
+	Update(member *models.Member) error
+	Delete(id string) error
+	// GetAll returns all members ordered by name
+	GetAll() []*models.Member
+	GetByID(id string) (*models.Member, error)
+	// FindByName returns the members whose name matches ignoring case and
+	// extra whitespace
+	FindByName(name string) []*models.Member
+}
+
+type InMemoryMemberRepository struct {
+	members map[string]*models.Member
+	mutex   sync.RWMutex
+}
+
+func NewMemberRepository() MemberRepository {
+	return &InMemoryMemberRepository{
+		members: make(map[string]*models.Member),
+	}
+}
+
+func (r *InMemoryMemberRepository) Create(member *models.Member) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.members[member.ID] = member
+	return nil
+}
+
+func (r *InMemoryMemberRepository) Update(member *models.Member) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.members[member.ID]; !exists {
+		return ErrMemberNotFound
+	}
+
+	r.members[member.ID] = member
+	return nil
+}
+
+func (r *InMemoryMemberRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.members[id]; !exists {
+		return ErrMemberNotFound
+	}
+
+	delete(r.members, id)
+	return nil
+}
+
+func (r *InMemoryMemberRepository) GetAll() []*models.Member {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	members := make([]*models.Member, 0, len(r.members))
+	for _, member := range r.members {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	return members
+}
+
+func (r *InMemoryMemberRepository) GetByID(id string) (*models.Member, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	member, exists := r.members[id]
+	if !exists {
+		return nil, ErrMemberNotFound
+	}
+	return member, nil
+}
+
+func (r *InMemoryMemberRepository) FindByName(name string) []*models.Member {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	key := models.NormalizeName(name)
+	members := make([]*models.Member, 0)
+	for _, member := range r.members {
+		if models.NormalizeName(member.Name) == key {
+			members = append(members, member)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].CreatedAt.Before(members[j].CreatedAt)
+	})
+	return members
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"glofox-backend/internal/models"
+	"sort"
+	"time"
+)
+
+// resolveOverbooking brings the bookings of a class date back within its
+// capacity. The earliest bookings are kept and the rest are moved to the
+// front of the waitlist or cancelled, according to the resolution. Bookings
+// already checked in cannot be moved and always keep their spot. It expects
+// the caller to hold the mutex.
+func (r *InMemoryBookingRepository) resolveOverbooking(class *models.Class, date time.Time, options models.ClassChangeOptions, now time.Time) (models.OverbookingReport, error) {
+	target := models.BookingStatusCancelled
+	if options.Resolution == models.CapacityResolutionWaitlist {
+		target = models.BookingStatusWaitlisted
+	}
+
+	booked := r.getByClassAndDate(class.ID, date)
+	sort.SliceStable(booked, func(i, j int) bool {
+		return booked[i].CreatedAt.Before(booked[j].CreatedAt)
+	})
+
+	free := class.CapacityOn(date)
+	for _, booking := range booked {
+		if !booking.Status.CanTransitionTo(target) {
+			free--
+		}
+	}
+
+	report := models.OverbookingReport{
+		Date:           date,
+		Resolution:     options.Resolution,
+		Capacity:       class.CapacityOn(date),
+		KeptBookingIDs: make([]string, 0),
+	}
+	affected := make([]*models.Booking, 0)
+	for _, booking := range booked {
+		if !booking.Status.CanTransitionTo(target) {
+			report.KeptBookingIDs = append(report.KeptBookingIDs, booking.ID)
+			continue
+		}
+		if free > 0 {
+			report.KeptBookingIDs = append(report.KeptBookingIDs, booking.ID)
+			free--
+			continue
+		}
+		affected = append(affected, booking)
+	}
+
+	if target == models.BookingStatusCancelled {
+		for _, booking := range affected {
+			if err := r.cancelLocked(booking, options.Cancellation); err != nil {
+				return report, err
+			}
+		}
+		report.Affected = cloneBookings(affected)
+		return report, nil
+	}
+
+	// Moved bookings were made before anyone still waiting, so they queue
+	// ahead of them, earliest first
+	moved := make([]string, 0, len(affected))
+	for _, booking := range affected {
+		if err := booking.TransitionTo(models.BookingStatusWaitlisted, now); err != nil {
+			return report, err
+		}
+		entry := models.NewWaitlistEntry(booking)
+		r.waitlist[entry.ID] = entry
+		moved = append(moved, entry.ID)
+	}
+
+	if len(moved) > 0 {
+		key := slotKey(class.ID, date)
+		r.queues[key] = append(moved, r.queues[key]...)
+	}
+	report.Affected = cloneBookings(affected)
+	return report, nil
+}
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"glofox-backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createBookingsAt books the class on 2030-03-04 in the given order, each
+// booking created the given number of minutes after a fixed time, and
+// returns the booking IDs in the same order
+func createBookingsAt(t *testing.T, repo BookingRepository, classID string, minutes ...int) []string {
+	t.Helper()
+
+	base := time.Date(2029, 1, 1, 9, 0, 0, 0, time.UTC)
+	ids := make([]string, 0, len(minutes))
+	for i, minute := range minutes {
+		booking := newTestBooking(t, classID, fmt.Sprintf("Member %d", i), "2030-03-04")
+		booking.CreatedAt = base.Add(time.Duration(minute) * time.Minute)
+		require.NoError(t, repo.Create(booking))
+		ids = append(ids, booking.ID)
+	}
+	return ids
+}
+
+func setCapacity(t *testing.T, repo BookingRepository, classID string, capacity int, options models.ClassChangeOptions) []models.OverbookingReport {
+	t.Helper()
+
+	_, _, reports, err := repo.ApplyClassUpdate(classID, func(current *models.Class) (*models.Class, error) {
+		patch := models.ClassPatchInput{Capacity: &capacity}
+		return current.Updated(patch.Apply(current.ToInput()))
+	}, options)
+	require.NoError(t, err)
+	return reports
+}
+
+func affectedIDs(report models.OverbookingReport) []string {
+	ids := make([]string, 0, len(report.Affected))
+	for _, booking := range report.Affected {
+		ids = append(ids, booking.ID)
+	}
+	return ids
+}
+
+func TestResolveOverbooking_CancelKeepsEarliest(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 4)
+	// Stored in a different order than they were created in
+	ids := createBookingsAt(t, repo, class.ID, 30, 10, 40, 20)
+
+	reports := setCapacity(t, repo, class.ID, 2, models.ClassChangeOptions{
+		Resolution:   models.CapacityResolutionCancel,
+		Cancellation: models.Cancellation{Reason: "smaller room", CancelledAt: time.Now()},
+	})
+
+	require.Len(t, reports, 1)
+	assert.Equal(t, 2, reports[0].Capacity)
+	assert.Equal(t, []string{ids[1], ids[3]}, reports[0].KeptBookingIDs)
+	assert.Equal(t, []string{ids[0], ids[2]}, affectedIDs(reports[0]))
+	for _, id := range []string{ids[0], ids[2]} {
+		booking, err := repo.GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, models.BookingStatusCancelled, booking.Status)
+		assert.Equal(t, "smaller room", booking.Cancellation.Reason)
+	}
+}
+
+func TestResolveOverbooking_WaitlistQueuesAheadOfWaiting(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 3)
+	ids := createBookingsAt(t, repo, class.ID, 20, 10, 30)
+	waiting := newTestBooking(t, class.ID, "Waiting Member", "2030-03-04")
+	entry, err := repo.CreateOrWaitlist(waiting)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	reports := setCapacity(t, repo, class.ID, 1, models.ClassChangeOptions{
+		Resolution: models.CapacityResolutionWaitlist,
+	})
+
+	require.Len(t, reports, 1)
+	assert.Equal(t, []string{ids[1]}, reports[0].KeptBookingIDs)
+	assert.Equal(t, []string{ids[0], ids[2]}, affectedIDs(reports[0]))
+
+	queue := repo.GetWaitlist(class.ID, waiting.Date)
+	require.Len(t, queue, 3)
+	assert.Equal(t, ids[0], queue[0].BookingID)
+	assert.Equal(t, ids[2], queue[1].BookingID)
+	assert.Equal(t, waiting.ID, queue[2].BookingID)
+
+	// Raising the capacity again promotes the moved bookings first
+	setCapacity(t, repo, class.ID, 2, models.ClassChangeOptions{})
+	assertStatus(t, repo, ids[0], models.BookingStatusConfirmed)
+	assertStatus(t, repo, ids[2], models.BookingStatusWaitlisted)
+	assertStatus(t, repo, waiting.ID, models.BookingStatusWaitlisted)
+}
+
+func TestResolveOverbooking_KeepsCheckedInBookings(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 3)
+	ids := createBookingsAt(t, repo, class.ID, 10, 20, 30)
+	_, err := repo.UpdateStatus(ids[2], models.BookingStatusAttended)
+	require.NoError(t, err)
+
+	reports := setCapacity(t, repo, class.ID, 1, models.ClassChangeOptions{
+		Resolution: models.CapacityResolutionCancel,
+	})
+
+	// The checked-in member takes the only spot even though they booked last
+	require.Len(t, reports, 1)
+	assert.Equal(t, []string{ids[2]}, reports[0].KeptBookingIDs)
+	assert.Equal(t, []string{ids[0], ids[1]}, affectedIDs(reports[0]))
+	assertStatus(t, repo, ids[2], models.BookingStatusAttended)
+}
+
+func TestResolveOverbooking_RejectByDefault(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 3)
+	createBookingsAt(t, repo, class.ID, 10, 20, 30)
+
+	capacity := 2
+	_, conflicts, _, err := repo.ApplyClassUpdate(class.ID, func(current *models.Class) (*models.Class, error) {
+		patch := models.ClassPatchInput{Capacity: &capacity}
+		return current.Updated(patch.Apply(current.ToInput()))
+	}, models.ClassChangeOptions{})
+
+	var conflictErr *ClassUpdateConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, models.ConflictOverCapacity, conflicts[0].Reason)
+	assert.Len(t, repo.Find(BookingQuery{ClassID: class.ID, Statuses: []models.BookingStatus{models.BookingStatusConfirmed}}), 3)
+}
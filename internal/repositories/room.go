@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sort"
+	"sync"
+)
+
+var ErrRoomNotFound = errors.New("room not found")
+
+type RoomRepository interface {
+	Create(room *models.Room) error
+	Update(room *models.Room) error
+	Delete(id string) error
+	// GetAll returns all rooms ordered by name
+	GetAll() []*models.Room
+	GetByID(id string) (*models.Room, error)
+}
+
+type InMemoryRoomRepository struct {
+	rooms map[string]*models.Room
+	mutex sync.RWMutex
+}
+
+func NewRoomRepository() RoomRepository {
+	return &InMemoryRoomRepository{
+		rooms: make(map[string]*models.Room),
+	}
+}
+
+func (r *InMemoryRoomRepository) Create(room *models.Room) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rooms[room.ID] = room
+	return nil
+}
+
+func (r *InMemoryRoomRepository) Update(room *models.Room) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.rooms[room.ID]; !exists {
+		return ErrRoomNotFound
+	}
+
+	r.rooms[room.ID] = room
+	return nil
+}
+
+func (r *InMemoryRoomRepository) Delete(id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.rooms[id]; !exists {
+		return ErrRoomNotFound
+	}
+
+	delete(r.rooms, id)
+	return nil
+}
+
+func (r *InMemoryRoomRepository) GetAll() []*models.Room {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	rooms := make([]*models.Room, 0, len(r.rooms))
+	for _, room := range r.rooms {
+		rooms = append(rooms, room)
+	}
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].Name < rooms[j].Name
+	})
+	return rooms
+}
+
+func (r *InMemoryRoomRepository) GetByID(id string) (*models.Room, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	room, exists := r.rooms[id]
+	if !exists {
+		return nil, ErrRoomNotFound
+	}
+	return room, nil
+}
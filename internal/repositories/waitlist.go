@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"errors"
+	"glofox-backend/internal/models"
+	"sort"
+	"time"
+)
+
+var ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+
+func (r *InMemoryBookingRepository) CreateOrWaitlist(booking *models.Booking) (*models.WaitlistEntry, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	err := r.checkAvailability(booking)
+	if err == nil {
+		if err := booking.TransitionTo(models.BookingStatusConfirmed, now); err != nil {
+			return nil, err
+		}
+		r.insert(booking.Clone())
+		return nil, nil
+	}
+
+	var fullErr *ClassFullError
+	if !errors.As(err, &fullErr) {
+		return nil, err
+	}
+	if err := booking.TransitionTo(models.BookingStatusWaitlisted, now); err != nil {
+		return nil, err
+	}
+
+	entry := models.NewWaitlistEntry(booking)
+	r.insert(booking.Clone())
+	key := slotKey(entry.ClassID, entry.Date)
+	r.waitlist[entry.ID] = entry
+	r.queues[key] = append(r.queues[key], entry.ID)
+
+	return r.withPosition(entry), nil
+}
+
+// GetWaitlist returns the members still waiting for a class date in queue order
+func (r *InMemoryBookingRepository) GetWaitlist(classID string, date time.Time) []*models.WaitlistEntry {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	queue := r.queues[slotKey(classID, date)]
+	entries := make([]*models.WaitlistEntry, 0, len(queue))
+	for i, id := range queue {
+		entry := *r.waitlist[id]
+		entry.Position = i + 1
+		entries = append(entries, &entry)
+	}
+	return entries
+}
+
+// GetWaitlistEntry returns a waitlist entry with its current queue position
+func (r *InMemoryBookingRepository) GetWaitlistEntry(id string) (*models.WaitlistEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entry, exists := r.waitlist[id]
+	if !exists {
+		return nil, ErrWaitlistEntryNotFound
+	}
+	return r.withPosition(entry), nil
+}
+
+// GetPromotions returns the entries promoted for a class date, oldest first
+func (r *InMemoryBookingRepository) GetPromotions(classID string, date time.Time) []*models.WaitlistEntry {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	key := slotKey(classID, date)
+	promoted := make([]*models.WaitlistEntry, 0)
+	for _, entry := range r.waitlist {
+		if entry.IsPromoted() && slotKey(entry.ClassID, entry.Date) == key {
+			copied := *entry
+			promoted = append(promoted, &copied)
+		}
+	}
+
+	sort.Slice(promoted, func(i, j int) bool {
+		return promoted[i].PromotedAt.Before(*promoted[j].PromotedAt)
+	})
+	return promoted
+}
+
+func (r *InMemoryBookingRepository) PromoteWaitlist(classID string, date time.Time) ([]*models.WaitlistEntry, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	class, err := r.classRepo.GetByID(classID)
+	if err != nil {
+		return nil, ErrClassNotFound
+	}
+
+	return r.promoteWaitlist(class, date, time.Now()), nil
+}
+
+// promoteWaitlist moves waiting members into free spots in queue order. It
+// expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) promoteWaitlist(class *models.Class, date time.Time, now time.Time) []*models.WaitlistEntry {
+	key := slotKey(class.ID, date)
+	queue := r.queues[key]
+	promoted := make([]*models.WaitlistEntry, 0)
+	if !class.IsDateInRange(date) || r.closures.ClosureOn(date) != nil {
+		return promoted
+	}
+
+	free := class.CapacityOn(date) - len(r.getByClassAndDate(class.ID, date))
+	for free > 0 && len(queue) > 0 {
+		entry := r.waitlist[queue[0]]
+		queue = queue[1:]
+
+		if err := entry.Promote(r.bookings[entry.BookingID], now); err != nil {
+			continue
+		}
+
+		copied := *entry
+		promoted = append(promoted, &copied)
+		free--
+	}
+
+	if len(queue) == 0 {
+		delete(r.queues, key)
+	} else {
+		r.queues[key] = queue
+	}
+	return promoted
+}
+
+// removeFromWaitlist drops the waitlist entry held for a booking. It expects
+// the caller to hold the mutex.
+func (r *InMemoryBookingRepository) removeFromWaitlist(booking *models.Booking) {
+	key := slotKey(booking.ClassID, booking.Date)
+	queue := r.queues[key]
+	for i, id := range queue {
+		if r.waitlist[id].BookingID != booking.ID {
+			continue
+		}
+
+		delete(r.waitlist, id)
+		queue = append(queue[:i:i], queue[i+1:]...)
+		if len(queue) == 0 {
+			delete(r.queues, key)
+		} else {
+			r.queues[key] = queue
+		}
+		return
+	}
+}
+
+// withPosition returns a copy of the entry with its current queue position.
+// It expects the caller to hold the mutex.
+func (r *InMemoryBookingRepository) withPosition(entry *models.WaitlistEntry) *models.WaitlistEntry {
+	copied := *entry
+	copied.Position = 0
+	for i, id := range r.queues[slotKey(entry.ClassID, entry.Date)] {
+		if id == entry.ID {
+			copied.Position = i + 1
+			break
+		}
+	}
+	return &copied
+}
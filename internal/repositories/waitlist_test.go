@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"glofox-backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrWaitlist_KeepsSession(t *testing.T) {
+	classes, repo, _ := newTestRepositories(t, 1)
+	class, err := models.NewClass(models.ClassInput{
+		ClassName:       "Evening Yoga",
+		StartDate:       "2030-01-01",
+		EndDate:         "2030-12-31",
+		StartTime:       "18:00",
+		DurationMinutes: 60,
+		Capacity:        1,
+	})
+	require.NoError(t, err)
+	require.NoError(t, classes.Create(class))
+	session := class.SessionOn(time.Date(2030, 3, 4, 0, 0, 0, 0, time.UTC))
+
+	first := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	require.NoError(t, repo.Create(first))
+
+	waiting := newTestBooking(t, class.ID, "Jane Roe", "2030-03-04")
+	entry, err := repo.CreateOrWaitlist(waiting)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	stored, err := repo.GetByID(waiting.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BookingStatusWaitlisted, stored.Status)
+	assert.Equal(t, session, stored.Session)
+	assert.Equal(t, models.OccurrenceID(class.ID, stored.Date), stored.OccurrenceID)
+
+	_, err = repo.Cancel(first.ID, &models.Cancellation{CancelledAt: time.Now()})
+	require.NoError(t, err)
+
+	promoted, err := repo.GetByID(waiting.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BookingStatusConfirmed, promoted.Status)
+	assert.True(t, promoted.Session.Start.Equal(session.Start))
+}
+
+func TestWaitlist_PromotesInQueueOrder(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 2)
+
+	first := newTestBooking(t, class.ID, "John Doe", "2030-03-04")
+	second := newTestBooking(t, class.ID, "Jane Roe", "2030-03-04")
+	require.NoError(t, repo.Create(first))
+	require.NoError(t, repo.Create(second))
+
+	waiting := make([]*models.Booking, 0, 3)
+	for i, name := range []string{"Sam Poe", "Ann Loe", "Tim Koe"} {
+		booking := newTestBooking(t, class.ID, name, "2030-03-04")
+		entry, err := repo.CreateOrWaitlist(booking)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		assert.Equal(t, i+1, entry.Position)
+		waiting = append(waiting, booking)
+	}
+
+	// Each freed spot goes to the member waiting longest
+	_, err := repo.Cancel(second.ID, &models.Cancellation{CancelledAt: time.Now()})
+	require.NoError(t, err)
+	assertStatus(t, repo, waiting[0].ID, models.BookingStatusConfirmed)
+	assertStatus(t, repo, waiting[1].ID, models.BookingStatusWaitlisted)
+
+	// A waiting member leaving the queue moves the rest up without promoting
+	_, err = repo.Cancel(waiting[1].ID, &models.Cancellation{CancelledAt: time.Now()})
+	require.NoError(t, err)
+	queue := repo.GetWaitlist(class.ID, waiting[2].Date)
+	require.Len(t, queue, 1)
+	assert.Equal(t, waiting[2].ID, queue[0].BookingID)
+	assert.Equal(t, 1, queue[0].Position)
+	assertStatus(t, repo, waiting[2].ID, models.BookingStatusWaitlisted)
+
+	_, err = repo.Cancel(first.ID, &models.Cancellation{CancelledAt: time.Now()})
+	require.NoError(t, err)
+	assertStatus(t, repo, waiting[2].ID, models.BookingStatusConfirmed)
+	assert.Empty(t, repo.GetWaitlist(class.ID, waiting[2].Date))
+
+	promotions := repo.GetPromotions(class.ID, waiting[2].Date)
+	require.Len(t, promotions, 2)
+	assert.Equal(t, waiting[0].ID, promotions[0].BookingID)
+	assert.Equal(t, waiting[2].ID, promotions[1].BookingID)
+}
+
+func assertStatus(t *testing.T, repo BookingRepository, id string, status models.BookingStatus) {
+	t.Helper()
+
+	booking, err := repo.GetByID(id)
+	require.NoError(t, err)
+	assert.Equal(t, status, booking.Status)
+}
+
+func TestUpdateStatus_CannotConfirmWaitlisted(t *testing.T) {
+	_, repo, class := newTestRepositories(t, 1)
+	require.NoError(t, repo.Create(newTestBooking(t, class.ID, "John Doe", "2030-03-04")))
+	waiting := newTestBooking(t, class.ID, "Jane Roe", "2030-03-04")
+	_, err := repo.CreateOrWaitlist(waiting)
+	require.NoError(t, err)
+
+	_, err = repo.UpdateStatus(waiting.ID, models.BookingStatusConfirmed)
+	var transitionErr *models.InvalidTransitionError
+	require.ErrorAs(t, err, &transitionErr)
+
+	booked, waitlisted := repo.CountByClassAndDate(class.ID, waiting.Date)
+	assert.Equal(t, 1, booked)
+	assert.Equal(t, 1, waitlisted)
+	assertStatus(t, repo, waiting.ID, models.BookingStatusWaitlisted)
+}